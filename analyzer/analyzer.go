@@ -1,7 +1,11 @@
 package analyzer
 
 import (
+	"flag"
 	"go/ast"
+	"maps"
+	"reflect"
+	"slices"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
@@ -15,10 +19,39 @@ const (
 	StructMethodCheckName = "struct-method"
 	AlphabeticalCheckName = "alphabetical"
 	FunctionCheckName     = "function"
+	MethodAfterStructName = "method-after-struct"
+	ContiguousMethodsName = "contiguous-methods"
+	ExportedFirstName     = "exported-first"
+	TypeOrderName         = "type-order"
+	InterfaceMethodsName  = "interface-methods"
+
+	AlphabeticalScopesName    = "alphabetical-scopes"
+	NameComparatorName        = "name-comparator"
+	ConstructorPatternsName   = "constructor-patterns"
+	TypeAwareConstructorsName = "type-aware-constructors"
+	ConstructorOrderName      = "constructor-order"
+	ConstructorPlacementName  = "constructor-placement"
+	CrossFileName             = "cross-file"
+	FileLayoutName            = "file-layout"
+	StepdownName              = "stepdown"
+	HelperPlacementName       = "helper-placement"
+	VisibilityOrderName       = "visibility-order"
+	ReceiverKindName          = "receiver-kind"
+	InterfacesName            = "interfaces"
 )
 
+// alphabeticalScopes returns the features enabled by the alphabetical check for each scope.
+func alphabeticalScopes() map[string]internal.Feature {
+	return map[string]internal.Feature{
+		"constructors": internal.AlphabeticalConstructors,
+		"functions":    internal.AlphabeticalFunctions,
+		"methods":      internal.AlphabeticalMethods,
+		"types":        internal.AlphabeticalTypes,
+	}
+}
+
 func NewAnalyzer() *analysis.Analyzer {
-	f := funcorder{}
+	f := newFuncorder()
 
 	a := &analysis.Analyzer{
 		Name:     "funcorder",
@@ -28,50 +61,162 @@ func NewAnalyzer() *analysis.Analyzer {
 		Requires: []*analysis.Analyzer{inspect.Analyzer},
 	}
 
-	a.Flags.BoolVar(&f.constructorCheck, ConstructorCheckName, true,
-		"Checks that constructors are placed after the structure declaration.")
-	a.Flags.BoolVar(&f.structMethodCheck, StructMethodCheckName, true,
-		"Checks if the exported methods of a structure are placed before the unexported ones.")
-	a.Flags.BoolVar(&f.alphabeticalCheck, AlphabeticalCheckName, false,
-		"Checks if the constructors and/or structure methods are sorted alphabetically.")
-	a.Flags.BoolVar(&f.functionCheck, FunctionCheckName, false,
-		"Checks that exported functions are placed before unexported functions.")
+	// the analyzer of the interface facts also analyzes all the dependencies,
+	// so it's only required by the interface-methods check.
+	f.interfaceMethods.analyzer = a
+	f.registerFlags(&a.Flags)
 
 	return a
 }
 
+// newInterfaceFactsAnalyzer creates the analyzer that exports the order of the methods of the interfaces
+// of each package as facts, and returns the order of the interfaces of the package and its dependencies.
+func newInterfaceFactsAnalyzer() *analysis.Analyzer {
+	return &analysis.Analyzer{
+		Name:       "funcorderinterfaces",
+		Doc:        "exports the order of the methods of the interfaces, used by the interface-methods check of funcorder",
+		URL:        "https://github.com/manuelarte/funcorder",
+		Run:        runInterfaceFacts,
+		ResultType: reflect.TypeFor[internal.InterfaceOrders](),
+		FactTypes:  []analysis.Fact{new(internal.InterfaceMethodsFact)},
+	}
+}
+
+func runInterfaceFacts(pass *analysis.Pass) (any, error) {
+	return internal.ExportInterfaceFacts(pass), nil
+}
+
 type funcorder struct {
 	constructorCheck  bool
 	structMethodCheck bool
 	alphabeticalCheck bool
 	functionCheck     bool
+	methodAfterStruct bool
+	contiguousMethods bool
+	exportedFirst     bool
+	typeOrder         bool
+	interfaceMethods  requiresFlag
+
+	alphabeticalScopes    enumListFlag
+	nameComparator        enumFlag
+	constructorPatterns   patternsFlag
+	typeAwareConstructors bool
+	constructorOrder      patternsFlag
+	constructorPlacement  enumFlag
+	crossFile             enumFlag
+	fileLayout            enumListFlag
+	stepdown              enumFlag
+	helperPlacement       enumFlag
+	visibilityOrder       enumFlag
+	receiverKind          enumFlag
+	interfaces            qualifiedNamesFlag
 }
 
-func (f *funcorder) run(pass *analysis.Pass) (any, error) {
-	insp, found := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
-	if !found {
-		//nolint:nilnil // impossible case.
-		return nil, nil
+func newFuncorder() *funcorder {
+	sections := make([]string, 0, len(internal.Sections()))
+	for _, s := range internal.Sections() {
+		sections = append(sections, string(s))
 	}
 
-	var enabledCheckers internal.Feature
-	if f.constructorCheck {
-		enabledCheckers.Enable(internal.ConstructorCheck)
-	}
+	scopes := slices.Collect(maps.Keys(alphabeticalScopes()))
+	slices.Sort(scopes)
 
-	if f.structMethodCheck {
-		enabledCheckers.Enable(internal.StructMethodCheck)
+	return &funcorder{
+		interfaceMethods:   requiresFlag{required: newInterfaceFactsAnalyzer()},
+		alphabeticalScopes: newEnumListFlag([]string{"constructors", "methods"}, scopes...),
+		nameComparator: newEnumFlag(string(internal.BytewiseComparator), string(internal.BytewiseComparator),
+			string(internal.CaseInsensitiveComparator), string(internal.NaturalComparator)),
+		constructorPatterns: newPatternsFlag("New", "Must"),
+		constructorPlacement: newEnumFlag(string(internal.ConstructorAfterStruct),
+			string(internal.ConstructorAfterStruct), string(internal.ConstructorAdjacent)),
+		crossFile: newEnumFlag(string(internal.CrossFileNone),
+			string(internal.CrossFileNone), string(internal.CrossFileSameFile), string(internal.CrossFilePerFile)),
+		fileLayout: newEnumListFlag(nil, sections...),
+		stepdown: newEnumFlag(string(internal.StepdownNone),
+			string(internal.StepdownNone), string(internal.StepdownCallerFirst), string(internal.StepdownCalleeFirst)),
+		helperPlacement: newEnumFlag(string(internal.HelperPlacementNone), string(internal.HelperPlacementNone),
+			string(internal.HelperPlacementAfterCaller), string(internal.HelperPlacementBottom)),
+		visibilityOrder: newEnumFlag(string(internal.VisibilityExportedFirst),
+			string(internal.VisibilityExportedFirst), string(internal.VisibilityUnexportedFirst)),
+		receiverKind: newEnumFlag(string(internal.ReceiverKindNone), string(internal.ReceiverKindNone),
+			string(internal.ReceiverKindValueFirst), string(internal.ReceiverKindPointerFirst)),
 	}
+}
 
-	if f.alphabeticalCheck {
-		enabledCheckers.Enable(internal.AlphabeticalCheck)
-	}
+func (f *funcorder) registerFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&f.constructorCheck, ConstructorCheckName, true,
+		"Checks that constructors are placed after the structure declaration.")
+	fs.BoolVar(&f.structMethodCheck, StructMethodCheckName, true,
+		"Checks if the exported methods of a structure are placed before the unexported ones.")
+	fs.BoolVar(&f.alphabeticalCheck, AlphabeticalCheckName, false,
+		"Checks if the constructors and/or structure methods are sorted alphabetically.")
+	fs.BoolVar(&f.functionCheck, FunctionCheckName, false,
+		"Checks that exported functions are placed before unexported functions.")
+	fs.BoolVar(&f.methodAfterStruct, MethodAfterStructName, false,
+		"Checks that the methods of a structure are placed after the structure declaration.")
+	fs.BoolVar(&f.contiguousMethods, ContiguousMethodsName, false,
+		"Checks that the methods of a structure are not interleaved with other functions.")
+	fs.BoolVar(&f.exportedFirst, ExportedFirstName, false,
+		"Checks that exported declarations, of any kind, are placed before unexported declarations.")
+	fs.BoolVar(&f.typeOrder, TypeOrderName, false,
+		"Checks that exported types are placed before unexported types, "+
+			"and that their constructors and methods follow the order of the type declarations.")
+	fs.Var(&f.interfaceMethods, InterfaceMethodsName,
+		"Checks that the methods of a structure implementing an interface are contiguous, "+
+			"and follow the order of the interface declaration.")
+	fs.Var(&f.alphabeticalScopes, AlphabeticalScopesName,
+		"Comma separated list of the scopes sorted alphabetically if alphabetical is enabled: "+
+			"constructors, functions, methods and types.")
+	fs.Var(&f.nameComparator, NameComparatorName,
+		"How the names are compared by the alphabetical checks: bytewise, case-insensitive or natural "+
+			"(word by word ignoring the case, and the numbers by their value).")
+	fs.Var(&f.constructorPatterns, ConstructorPatternsName,
+		"Comma separated list of prefixes, or regular expressions, that the name of a constructor starts with.")
+	fs.BoolVar(&f.typeAwareConstructors, TypeAwareConstructorsName, false,
+		"Detects constructors using the type checker, any function whose 1st result is a type of the package.")
+	fs.Var(&f.constructorOrder, ConstructorOrderName,
+		"Comma separated list of prefixes, or regular expressions, of the constructors in the expected order, "+
+			"e.g. New,NewFrom|NewWith,Must. A constructor calling another one is placed directly after it.")
+	fs.Var(&f.constructorPlacement, ConstructorPlacementName,
+		"Where the constructors are placed: after-struct, anywhere between the structure declaration and its methods, "+
+			"or adjacent, directly after the structure declaration.")
+	fs.Var(&f.crossFile, CrossFileName,
+		"Policy for the constructors and methods declared in another file than their type: "+
+			"none, same-file (they are reported) or per-file (their order is checked in each file).")
+	fs.Var(&f.fileLayout, FileLayoutName,
+		"Comma separated list of the sections of the file in the expected order, e.g. const,var,type,func. "+
+			"Sections: import, const, var, type, constructor, method and func.")
+	fs.Var(&f.stepdown, StepdownName,
+		"Direction of the calls between the functions of a file: none, "+
+			"caller-first (a function is placed after its first caller) or callee-first (before its last caller).")
+	fs.Var(&f.helperPlacement, HelperPlacementName,
+		"Where the unexported functions are placed: none, after-caller (a helper with only one caller is placed "+
+			"right after it) or bottom (after the last exported function, like the function check).")
+	fs.Var(&f.visibilityOrder, VisibilityOrderName,
+		"Whether the exported, or the unexported, methods and functions are placed first, "+
+			"used by the struct-method and function checks: exported-first or unexported-first.")
+	fs.Var(&f.receiverKind, ReceiverKindName,
+		"Whether the value receiver, or the pointer receiver, methods of a structure are placed first, "+
+			"within the exported and unexported methods, used by the struct-method check: "+
+			"none, value-first or pointer-first.")
+	fs.Var(&f.interfaces, InterfacesName,
+		"Comma separated list of the qualified names of the interfaces checked by the interface-methods check, "+
+			"e.g. io.Reader,net/http.Handler, in addition to the interfaces declared in the package and its imports.")
+}
 
-	if f.functionCheck {
-		enabledCheckers.Enable(internal.FunctionCheck)
+func (f *funcorder) run(pass *analysis.Pass) (any, error) {
+	insp, found := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	if !found {
+		//nolint:nilnil // impossible case.
+		return nil, nil
 	}
 
-	fp := internal.NewFileProcessor(enabledCheckers)
+	fp := internal.NewFileProcessor(f.settings())
+	fp.UseTypes(pass.Pkg, pass.TypesInfo)
+	fp.UsePackageFiles(pass.Files)
+	// nil if the interface-methods check is disabled, as the analyzer of the interface facts is not required
+	orders, _ := pass.ResultOf[f.interfaceMethods.required].(internal.InterfaceOrders)
+	fp.UseInterfaces(pass, orders)
 
 	nodeFilter := []ast.Node{
 		(*ast.File)(nil),
@@ -84,6 +229,7 @@ func (f *funcorder) run(pass *analysis.Pass) (any, error) {
 		case *ast.File:
 			fp.Analyze(pass)
 			fp.ResetStructs()
+			fp.SetFile(node)
 
 		case *ast.FuncDecl:
 			fp.AddFuncDecl(node)
@@ -98,3 +244,70 @@ func (f *funcorder) run(pass *analysis.Pass) (any, error) {
 	//nolint:nilnil //any, error
 	return nil, nil
 }
+
+func (f *funcorder) settings() internal.Settings {
+	var enabledCheckers internal.Feature
+
+	if f.constructorCheck {
+		enabledCheckers.Enable(internal.ConstructorCheck)
+	}
+
+	if f.structMethodCheck {
+		enabledCheckers.Enable(internal.StructMethodCheck)
+	}
+
+	if f.alphabeticalCheck {
+		scopes := alphabeticalScopes()
+		for _, scope := range f.alphabeticalScopes.values {
+			enabledCheckers.Enable(scopes[scope])
+		}
+	}
+
+	if f.functionCheck {
+		enabledCheckers.Enable(internal.FunctionCheck)
+	}
+
+	if f.methodAfterStruct {
+		enabledCheckers.Enable(internal.MethodAfterStructCheck)
+	}
+
+	if f.contiguousMethods {
+		enabledCheckers.Enable(internal.ContiguousMethodsCheck)
+	}
+
+	if f.exportedFirst {
+		enabledCheckers.Enable(internal.ExportedFirstCheck)
+	}
+
+	if f.typeOrder {
+		enabledCheckers.Enable(internal.TypeOrderCheck)
+	}
+
+	if f.interfaceMethods.value {
+		enabledCheckers.Enable(internal.InterfaceMethodsCheck)
+	}
+
+	if f.typeAwareConstructors {
+		enabledCheckers.Enable(internal.TypeAwareConstructors)
+	}
+
+	fileLayout := make([]internal.Section, 0, len(f.fileLayout.values))
+	for _, s := range f.fileLayout.values {
+		fileLayout = append(fileLayout, internal.Section(s))
+	}
+
+	return internal.Settings{
+		Features:             enabledCheckers,
+		ConstructorPatterns:  f.constructorPatterns.regexps,
+		ConstructorOrder:     f.constructorOrder.regexps,
+		ConstructorPlacement: internal.ConstructorPlacement(f.constructorPlacement.value),
+		CrossFile:            internal.CrossFilePolicy(f.crossFile.value),
+		FileLayout:           fileLayout,
+		Stepdown:             internal.StepdownDirection(f.stepdown.value),
+		HelperPlacement:      internal.HelperPlacement(f.helperPlacement.value),
+		VisibilityOrder:      internal.VisibilityOrder(f.visibilityOrder.value),
+		NameComparator:       internal.NameComparator(f.nameComparator.value),
+		ReceiverKindOrder:    internal.ReceiverKindOrder(f.receiverKind.value),
+		Interfaces:           f.interfaces.names,
+	}
+}
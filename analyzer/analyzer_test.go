@@ -11,6 +11,7 @@ func TestAnalyzer(t *testing.T) {
 		desc     string
 		patterns string
 		options  map[string]string
+		fix      bool
 	}{
 		{
 			desc:     "default",
@@ -75,6 +76,291 @@ func TestAnalyzer(t *testing.T) {
 				FunctionCheckName:     "true",
 			},
 		},
+		{
+			desc:     "generic types",
+			patterns: "generics",
+			options: map[string]string{
+				ConstructorCheckName:  "true",
+				StructMethodCheckName: "true",
+				AlphabeticalCheckName: "true",
+			},
+		},
+		{
+			desc:     "constructor patterns",
+			patterns: "constructor-patterns",
+			options: map[string]string{
+				ConstructorCheckName:    "true",
+				StructMethodCheckName:   "true",
+				ConstructorPatternsName: "Open, Dial,(Parse|From)",
+			},
+		},
+		{
+			desc:     "type aware constructors",
+			patterns: "type-aware-constructors",
+			options: map[string]string{
+				ConstructorCheckName:      "true",
+				StructMethodCheckName:     "true",
+				TypeAwareConstructorsName: "true",
+			},
+		},
+		{
+			desc:     "unexported constructors",
+			patterns: "unexported-constructors",
+			options: map[string]string{
+				ConstructorCheckName:  "true",
+				StructMethodCheckName: "true",
+				FunctionCheckName:     "true",
+			},
+		},
+		{
+			desc:     "bare constructor in a single type package",
+			patterns: "bare-constructor",
+			options: map[string]string{
+				ConstructorCheckName:  "true",
+				StructMethodCheckName: "true",
+			},
+		},
+		{
+			desc:     "bare constructor in a package with several types",
+			patterns: "bare-constructor-multiple-types",
+			options: map[string]string{
+				ConstructorCheckName:  "true",
+				StructMethodCheckName: "true",
+			},
+		},
+		{
+			desc:     "constructor order",
+			patterns: "constructor-order",
+			options: map[string]string{
+				ConstructorCheckName:  "true",
+				StructMethodCheckName: "true",
+				AlphabeticalCheckName: "true",
+				ConstructorOrderName:  `New,New\w*(From|With),Must`,
+			},
+		},
+		{
+			desc:     "constructor order with a quantified group",
+			patterns: "constructor-order-quantified",
+			options: map[string]string{
+				ConstructorCheckName:  "true",
+				StructMethodCheckName: "true",
+				ConstructorOrderName:  `New\w{2,}(From|With),New`,
+			},
+		},
+		{
+			desc:     "constructor placement adjacent",
+			patterns: "constructor-adjacent",
+			options: map[string]string{
+				ConstructorPlacementName: "adjacent",
+			},
+		},
+		{
+			desc:     "exported first",
+			patterns: "exported-first",
+			options: map[string]string{
+				ExportedFirstName: "true",
+			},
+		},
+		{
+			desc:     "alphabetical functions",
+			patterns: "alphabetical-functions",
+			options: map[string]string{
+				AlphabeticalCheckName:  "true",
+				AlphabeticalScopesName: "functions",
+			},
+		},
+		{
+			desc:     "alphabetical minimal moves",
+			patterns: "alphabetical-minimal-moves",
+			options: map[string]string{
+				AlphabeticalCheckName: "true",
+			},
+		},
+		{
+			desc:     "receiver kind pointer first",
+			patterns: "receiver-kind-pointer-first",
+			options: map[string]string{
+				ReceiverKindName: "pointer-first",
+			},
+		},
+		{
+			desc:     "interface methods",
+			patterns: "interface-methods",
+			options: map[string]string{
+				InterfaceMethodsName: "true",
+				InterfacesName:       "io.ReadWriteCloser",
+			},
+		},
+		{
+			desc:     "interface methods declared in another package",
+			patterns: "interface-facts/...",
+			options: map[string]string{
+				InterfaceMethodsName: "true",
+				InterfacesName:       "interface-facts/ports.Repository",
+			},
+		},
+		{
+			desc:     "interface methods declared in an imported package without configuration",
+			patterns: "interface-facts/...",
+			options: map[string]string{
+				InterfaceMethodsName: "true",
+			},
+		},
+		{
+			desc:     "configured interfaces declared in a dependency not imported",
+			patterns: "interface-transitive/...",
+			options: map[string]string{
+				InterfaceMethodsName: "true",
+				InterfacesName:       "interface-transitive/ports.Repository,io.ReadWriteCloser,interface-transitive/ports.Missing",
+			},
+		},
+		{
+			desc:     "natural name comparator",
+			patterns: "name-comparator-natural",
+			options: map[string]string{
+				AlphabeticalCheckName: "true",
+				NameComparatorName:    "natural",
+			},
+		},
+		{
+			desc:     "case insensitive name comparator",
+			patterns: "name-comparator-case-insensitive",
+			options: map[string]string{
+				AlphabeticalCheckName:  "true",
+				AlphabeticalScopesName: "functions",
+				NameComparatorName:     "case-insensitive",
+			},
+		},
+		{
+			desc:     "type order",
+			patterns: "type-order",
+			options: map[string]string{
+				AlphabeticalCheckName:  "true",
+				AlphabeticalScopesName: "constructors,methods,types",
+				TypeOrderName:          "true",
+			},
+		},
+		{
+			desc:     "file layout",
+			patterns: "file-layout",
+			options: map[string]string{
+				FileLayoutName: "const, var, type, func",
+			},
+		},
+		{
+			desc:     "file layout with constructors and methods sections",
+			patterns: "file-layout-type-members",
+			options: map[string]string{
+				ConstructorCheckName:  "false",
+				StructMethodCheckName: "false",
+				FileLayoutName:        "type,constructor,method,func",
+			},
+		},
+		{
+			desc:     "stepdown caller first",
+			patterns: "stepdown-caller-first",
+			options: map[string]string{
+				StepdownName: "caller-first",
+			},
+		},
+		{
+			desc:     "stepdown callee first",
+			patterns: "stepdown-callee-first",
+			options: map[string]string{
+				StepdownName: "callee-first",
+			},
+		},
+		{
+			desc:     "helper placement after caller",
+			patterns: "helper-after-caller",
+			options: map[string]string{
+				StructMethodCheckName: "false",
+				HelperPlacementName:   "after-caller",
+			},
+		},
+		{
+			desc:     "helper placement bottom",
+			patterns: "helper-bottom",
+			options: map[string]string{
+				HelperPlacementName: "bottom",
+			},
+		},
+		{
+			desc:     "contiguous methods",
+			patterns: "contiguous-methods",
+			options: map[string]string{
+				ContiguousMethodsName: "true",
+			},
+		},
+		{
+			desc:     "cross file, same file policy",
+			patterns: "cross-file-same-file",
+			options: map[string]string{
+				CrossFileName: "same-file",
+			},
+		},
+		{
+			desc:     "cross file, per file policy",
+			patterns: "cross-file-per-file",
+			options: map[string]string{
+				CrossFileName: "per-file",
+			},
+		},
+		{
+			desc:     "cross file, per file policy with exported first",
+			patterns: "cross-file-per-file-exported-first",
+			options: map[string]string{
+				CrossFileName:     "per-file",
+				ExportedFirstName: "true",
+			},
+		},
+		{
+			desc:     "constructor check suggested fixes",
+			patterns: "constructor-fix",
+			options: map[string]string{
+				ConstructorCheckName:  "true",
+				StructMethodCheckName: "false",
+			},
+			fix: true,
+		},
+		{
+			desc:     "struct method check suggested fixes",
+			patterns: "struct-method-fix",
+			options: map[string]string{
+				ConstructorCheckName:  "false",
+				StructMethodCheckName: "true",
+				AlphabeticalCheckName: "true",
+			},
+			fix: true,
+		},
+		{
+			desc:     "unexported first suggested fixes",
+			patterns: "unexported-first",
+			options: map[string]string{
+				FunctionCheckName:   "true",
+				VisibilityOrderName: "unexported-first",
+			},
+			fix: true,
+		},
+		{
+			desc:     "receiver kind value first suggested fixes",
+			patterns: "receiver-kind-value-first",
+			options: map[string]string{
+				AlphabeticalCheckName: "true",
+				ReceiverKindName:      "value-first",
+			},
+			fix: true,
+		},
+		{
+			desc:     "method after struct check suggested fixes",
+			patterns: "method-after-struct",
+			options: map[string]string{
+				ConstructorCheckName:  "false",
+				StructMethodCheckName: "false",
+				MethodAfterStructName: "true",
+			},
+			fix: true,
+		},
 	}
 
 	for _, test := range testCases {
@@ -88,7 +374,17 @@ func TestAnalyzer(t *testing.T) {
 				}
 			}
 
+			if test.fix {
+				analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), a, test.patterns)
+
+				return
+			}
+
 			analysistest.Run(t, analysistest.TestData(), a, test.patterns)
 		})
 	}
 }
+
+func TestInterfaceFactsAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), newInterfaceFactsAnalyzer(), "interface-orders")
+}
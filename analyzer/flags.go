@@ -0,0 +1,228 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// patternsFlag is a comma separated list of regular expressions that have to match the start of a name.
+// The matching is case-insensitive, so `New` matches both `NewServer` and `newServer`.
+// The commas inside braces, parentheses or brackets are part of the pattern, e.g. `New\w{1,3}`.
+type patternsFlag struct {
+	patterns []string
+	regexps  []*regexp.Regexp
+}
+
+func newPatternsFlag(patterns ...string) patternsFlag {
+	var f patternsFlag
+
+	_ = f.Set(strings.Join(patterns, ","))
+
+	return f
+}
+
+func (f *patternsFlag) String() string {
+	return strings.Join(f.patterns, ",")
+}
+
+func (f *patternsFlag) Set(value string) error {
+	var (
+		patterns []string
+		regexps  []*regexp.Regexp
+	)
+
+	for _, p := range splitTopLevel(value) {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+
+		re, err := regexp.Compile("(?i)^(?:" + p + ")")
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+
+		patterns = append(patterns, p)
+		regexps = append(regexps, re)
+	}
+
+	f.patterns, f.regexps = patterns, regexps
+
+	return nil
+}
+
+// splitTopLevel splits the regular expressions on the commas that are not inside braces, parentheses or brackets,
+// and not escaped.
+func splitTopLevel(value string) []string {
+	var (
+		parts   []string
+		depth   int
+		start   int
+		escaped bool
+	)
+
+	for i, r := range value {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '{' || r == '(' || r == '[':
+			depth++
+		case r == '}' || r == ')' || r == ']':
+			depth = max(depth-1, 0)
+		case r == ',' && depth == 0:
+			parts = append(parts, value[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, value[start:])
+}
+
+// enumFlag is a value that has to be one of the allowed values.
+type enumFlag struct {
+	value   string
+	allowed []string
+}
+
+func newEnumFlag(value string, allowed ...string) enumFlag {
+	return enumFlag{
+		value:   value,
+		allowed: allowed,
+	}
+}
+
+func (f *enumFlag) String() string {
+	return f.value
+}
+
+func (f *enumFlag) Set(value string) error {
+	value = strings.TrimSpace(value)
+	if !slices.Contains(f.allowed, value) {
+		return fmt.Errorf("invalid value %q, allowed values are %s", value, strings.Join(f.allowed, ", "))
+	}
+
+	f.value = value
+
+	return nil
+}
+
+// enumListFlag is a comma separated list of values, each of them has to be one of the allowed values.
+type enumListFlag struct {
+	values  []string
+	allowed []string
+}
+
+func newEnumListFlag(values []string, allowed ...string) enumListFlag {
+	return enumListFlag{
+		values:  values,
+		allowed: allowed,
+	}
+}
+
+func (f *enumListFlag) String() string {
+	return strings.Join(f.values, ",")
+}
+
+func (f *enumListFlag) Set(value string) error {
+	var values []string
+
+	for v := range strings.SplitSeq(value, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		if !slices.Contains(f.allowed, v) {
+			return fmt.Errorf("invalid value %q, allowed values are %s", v, strings.Join(f.allowed, ", "))
+		}
+
+		if slices.Contains(values, v) {
+			return fmt.Errorf("duplicated value %q", v)
+		}
+
+		values = append(values, v)
+	}
+
+	f.values = values
+
+	return nil
+}
+
+// qualifiedNamesFlag is a comma separated list of qualified names, the path of the package and the name,
+// e.g. `io.Reader` or `net/http.Handler`.
+type qualifiedNamesFlag struct {
+	names []string
+}
+
+func (f *qualifiedNamesFlag) String() string {
+	return strings.Join(f.names, ",")
+}
+
+func (f *qualifiedNamesFlag) Set(value string) error {
+	var names []string
+
+	for n := range strings.SplitSeq(value, ",") {
+		n = strings.TrimSpace(n)
+		if n == "" {
+			continue
+		}
+
+		if i := strings.LastIndex(n, "."); i <= 0 || i == len(n)-1 {
+			return fmt.Errorf("invalid qualified name %q, expected the package path and the name, e.g. io.Reader", n)
+		}
+
+		names = append(names, n)
+	}
+
+	f.names = names
+
+	return nil
+}
+
+// requiresFlag is a boolean flag that adds the required analyzer to the requirements of the analyzer if it's true,
+// so the required analyzer only runs if it's needed.
+type requiresFlag struct {
+	value bool
+
+	// the analyzer with the flag, nil for the rewriter
+	analyzer *analysis.Analyzer
+	required *analysis.Analyzer
+}
+
+func (f *requiresFlag) IsBoolFlag() bool {
+	return true
+}
+
+func (f *requiresFlag) String() string {
+	return strconv.FormatBool(f.value)
+}
+
+func (f *requiresFlag) Set(value string) error {
+	v, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("invalid boolean value %q", value)
+	}
+
+	f.value = v
+
+	if f.analyzer == nil {
+		return nil
+	}
+
+	f.analyzer.Requires = slices.DeleteFunc(f.analyzer.Requires, func(a *analysis.Analyzer) bool {
+		return a == f.required
+	})
+
+	if v {
+		f.analyzer.Requires = append(f.analyzer.Requires, f.required)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package analyzer
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+func TestPatternsFlag(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		value   string
+		want    []string
+		matches string
+	}{
+		{
+			desc:    "prefixes",
+			value:   "New, Must",
+			want:    []string{"New", "Must"},
+			matches: "MustServer",
+		},
+		{
+			desc:    "quantified pattern",
+			value:   `New\w{1,3},Must`,
+			want:    []string{`New\w{1,3}`, "Must"},
+			matches: "NewABC",
+		},
+		{
+			desc:    "alternation and character class with commas",
+			value:   `(Parse|From),[,a]New`,
+			want:    []string{"(Parse|From)", "[,a]New"},
+			matches: ",New",
+		},
+		{
+			desc:    "escaped brace",
+			value:   `New\{,Must`,
+			want:    []string{`New\{`, "Must"},
+			matches: "New{",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			var f patternsFlag
+
+			if err := f.Set(test.value); err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.Equal(f.patterns, test.want) {
+				t.Errorf("Set(%q) patterns = %q, want %q", test.value, f.patterns, test.want)
+			}
+
+			if !slices.ContainsFunc(f.regexps, func(re *regexp.Regexp) bool {
+				return re.MatchString(test.matches)
+			}) {
+				t.Errorf("Set(%q) doesn't match %q", test.value, test.matches)
+			}
+		})
+	}
+}
+
+func TestPatternsFlagInvalid(t *testing.T) {
+	var f patternsFlag
+
+	if err := f.Set(`New(,Must`); err == nil {
+		t.Errorf("Set of an unclosed group: got no error, patterns %q", f.patterns)
+	}
+}
+
+func TestInterfaceMethodsRequiresFacts(t *testing.T) {
+	a := NewAnalyzer()
+
+	isInterfaceFacts := func(r *analysis.Analyzer) bool {
+		return len(r.FactTypes) > 0
+	}
+
+	if slices.ContainsFunc(a.Requires, isInterfaceFacts) || len(a.FactTypes) > 0 {
+		t.Fatalf("the interface facts are required by default, requires %v, facts %v", a.Requires, a.FactTypes)
+	}
+
+	for _, value := range []string{"true", "true", "false"} {
+		if err := a.Flags.Set(InterfaceMethodsName, value); err != nil {
+			t.Fatal(err)
+		}
+
+		want := 0
+		if value == "true" {
+			want = 1
+		}
+
+		got := 0
+
+		for _, r := range a.Requires {
+			if isInterfaceFacts(r) {
+				got++
+			}
+		}
+
+		if got != want {
+			t.Errorf("after %s=%s, the interface facts analyzer is required %d times, want %d",
+				InterfaceMethodsName, value, got, want)
+		}
+	}
+}
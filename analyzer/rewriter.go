@@ -0,0 +1,38 @@
+package analyzer
+
+import (
+	"flag"
+	"go/parser"
+	"go/token"
+
+	"github.com/manuelarte/funcorder/internal"
+)
+
+// Rewriter rewrites Go files so their declarations follow the order checked by the analyzer.
+type Rewriter struct {
+	// Flags are the same flags as the analyzer ones, used to enable or disable the checks.
+	Flags flag.FlagSet
+
+	f *funcorder
+}
+
+// NewRewriter creates a new rewriter with the same default checks as the analyzer.
+func NewRewriter() *Rewriter {
+	r := &Rewriter{f: newFuncorder()}
+	r.f.registerFlags(&r.Flags)
+
+	return r
+}
+
+// Rewrite returns the source of the file with its declarations placed in the canonical layout
+// of the enabled checks.
+func (r *Rewriter) Rewrite(filename string, src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	return internal.RewriteFile(r.f.settings(), fset, file, src)
+}
@@ -0,0 +1,119 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRewriter(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		dir     string
+		options map[string]string
+	}{
+		{
+			desc: "default",
+			dir:  "default",
+		},
+		{
+			desc: "all options",
+			dir:  "all-options",
+			options: map[string]string{
+				AlphabeticalCheckName: "true",
+				FunctionCheckName:     "true",
+			},
+		},
+		{
+			desc: "constructor order",
+			dir:  "constructor-order",
+			options: map[string]string{
+				AlphabeticalCheckName: "true",
+				ConstructorOrderName:  `New,New\w*(From|With),Must`,
+			},
+		},
+		{
+			desc: "alphabetical functions",
+			dir:  "alphabetical-functions",
+			options: map[string]string{
+				AlphabeticalCheckName:  "true",
+				AlphabeticalScopesName: "functions",
+			},
+		},
+		{
+			desc: "unexported first",
+			dir:  "unexported-first",
+			options: map[string]string{
+				FunctionCheckName:   "true",
+				VisibilityOrderName: "unexported-first",
+			},
+		},
+		{
+			desc: "receiver kind",
+			dir:  "receiver-kind",
+			options: map[string]string{
+				ReceiverKindName: "value-first",
+			},
+		},
+		{
+			desc: "exported first",
+			dir:  "exported-first",
+			options: map[string]string{
+				ExportedFirstName: "true",
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			r := NewRewriter()
+
+			for k, v := range test.options {
+				err := r.Flags.Set(k, v)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			filenames, err := filepath.Glob(filepath.Join("testdata", "rewrite", test.dir, "*.go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, filename := range filenames {
+				assertRewrite(t, r, filename)
+			}
+		})
+	}
+}
+
+func assertRewrite(t *testing.T, r *Rewriter, filename string) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := os.ReadFile(filename + ".golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := r.Rewrite(filename, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != string(want) {
+		t.Errorf("%s: unexpected rewrite\n-- got --\n%s\n-- want --\n%s", filename, got, want)
+	}
+
+	again, err := r.Rewrite(filename, got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(again) != string(got) {
+		t.Errorf("%s: rewrite is not idempotent\n%s", filename, strings.TrimSpace(string(again)))
+	}
+}
@@ -0,0 +1,12 @@
+package alphabeticalfunctions
+
+func Format() {}
+
+func trim() {}
+
+// Close closes.
+func Close() {}
+
+func main() {}
+
+func lower() {}
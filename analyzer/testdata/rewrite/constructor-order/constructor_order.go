@@ -0,0 +1,53 @@
+package constructororder
+
+type Config struct {
+	path string
+}
+
+func NewConfigWithPath(path string) *Config {
+	return &Config{path: path}
+}
+
+func NewConfig() *Config {
+	return &Config{}
+}
+
+func MustConfigFromFile(path string) *Config {
+	c, err := NewConfigFromFile(path)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+func NewConfigFromFile(path string) (*Config, error) {
+	return &Config{path: path}, nil
+}
+
+type Server struct{}
+
+func NewServer() (*Server, error) {
+	return &Server{}, nil
+}
+
+func MustServer() *Server {
+	s, err := NewServer()
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+func NewServerFromConfig(*Config) *Server {
+	return &Server{}
+}
+
+func MustDefaultServer() *Server {
+	return &Server{}
+}
+
+func NewServerWithPort(int) *Server {
+	return &Server{}
+}
@@ -0,0 +1,43 @@
+package rewrite
+
+import "fmt"
+
+// helper is placed before the struct.
+func helper() string {
+	return "helper"
+}
+
+// NewMyStruct creates a new MyStruct.
+func NewMyStruct() *MyStruct {
+	return &MyStruct{Name: helper()}
+}
+
+func (m MyStruct) lenName() int {
+	return len(m.Name)
+}
+
+type (
+	// MyStruct is a struct.
+	MyStruct struct {
+		Name string
+	}
+
+	// Other is another struct.
+	Other struct{}
+)
+
+//nolint:unused // kept for documentation.
+func (m MyStruct) String() string {
+	return fmt.Sprintf("MyStruct(%s)", m.Name)
+}
+
+// Print prints the other struct.
+func (o Other) Print() {
+	fmt.Println("other")
+}
+
+func MustMyStruct() *MyStruct {
+	return NewMyStruct()
+}
+
+func Exported() {}
@@ -0,0 +1,28 @@
+package exportedfirst
+
+import "errors"
+
+const maxRetries = 3
+
+// state is the state of the connection.
+type state int
+
+func (s state) String() string {
+	return "state"
+}
+
+var ErrClosed = errors.New("closed")
+
+func helper() {}
+
+type Conn struct{}
+
+func (c *Conn) Close() error {
+	return ErrClosed
+}
+
+func Retry() int {
+	return maxRetries
+}
+
+var lastState state
@@ -0,0 +1,22 @@
+package receiverkind
+
+type MyStruct struct {
+	Name string
+}
+
+// SetName sets the name.
+func (m *MyStruct) SetName(name string) {
+	m.Name = name
+}
+
+func (m MyStruct) GetName() string {
+	return m.Name
+}
+
+func (m *MyStruct) reset() {
+	m.Name = ""
+}
+
+func (m MyStruct) isEmpty() bool {
+	return m.Name == ""
+}
@@ -0,0 +1,20 @@
+package unexportedfirst
+
+func Exported() {}
+
+// helper is a building block.
+func helper() {}
+
+type MyStruct struct{}
+
+func (m MyStruct) Name() string {
+	return "name"
+}
+
+func (m MyStruct) validate() bool {
+	return true
+}
+
+func Other() {}
+
+func last() {}
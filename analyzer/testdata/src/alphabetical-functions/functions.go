@@ -0,0 +1,32 @@
+package alphabeticalfunctions
+
+type MyStruct struct{}
+
+// The constructors and methods are not sorted, as only the functions scope is enabled.
+func NewMyStruct() *MyStruct {
+	return &MyStruct{}
+}
+
+func MustMyStruct() *MyStruct {
+	return NewMyStruct()
+}
+
+func (m MyStruct) World() string {
+	return "world"
+}
+
+func (m MyStruct) Hello() string {
+	return "hello"
+}
+
+func init() {}
+
+func Format() {}
+
+func Close() {} // want `function "Close" should be placed before function "Format"`
+
+func main() {}
+
+func trim() {}
+
+func lower() {} // want `function "lower" should be placed before function "trim"`
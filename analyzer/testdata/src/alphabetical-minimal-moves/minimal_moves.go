@@ -0,0 +1,25 @@
+package alphabeticalminimalmoves
+
+type MyStruct struct{}
+
+// Only "Zip" has to be moved, the other methods are already sorted.
+func (m MyStruct) Zip() {} // want `method "Zip" for struct "MyStruct" should be placed after method "Encode"`
+
+func (m MyStruct) Add() {}
+
+func (m MyStruct) Close() {}
+
+func (m MyStruct) Decode() {}
+
+func (m MyStruct) Encode() {}
+
+type Other struct{}
+
+// Only "Abs" has to be moved, it goes before all the other methods.
+func (o Other) Ceil() {}
+
+func (o Other) Floor() {}
+
+func (o Other) Abs() {} // want `method "Abs" for struct "Other" should be placed before method "Ceil"`
+
+func (o Other) Round() {}
@@ -0,0 +1,18 @@
+// Package client has several types, the bare constructor `New` belongs to the type it returns.
+package client
+
+type Option struct{}
+
+func New(opts ...Option) *Client { // want `constructor "New" for struct "Client" should be placed after the struct declaration`
+	return &Client{opts: opts}
+}
+
+func NewOption() Option {
+	return Option{}
+}
+
+type Client struct {
+	opts []Option
+}
+
+func (c *Client) Do() {}
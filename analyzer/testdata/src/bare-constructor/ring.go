@@ -0,0 +1,23 @@
+// Package ring is a single type package, its constructor is just `New`.
+package ring
+
+func NewWithCapacity(n int) *Ring { // want `constructor "NewWithCapacity" for struct "Ring" should be placed after the struct declaration`
+	return &Ring{values: make([]int, 0, n)}
+}
+
+// New creates a new ring.
+func New() *Ring { // want `constructor "New" for struct "Ring" should be placed after the struct declaration` `constructor "New" for struct "Ring" should be placed before constructor "NewWithCapacity"`
+	return &Ring{}
+}
+
+type Ring struct {
+	values []int
+}
+
+func (r *Ring) Len() int {
+	return len(r.values)
+}
+
+func Must() *Ring { // want `constructor "Must" for struct "Ring" should be placed before struct method "Len"`
+	return New()
+}
@@ -0,0 +1,79 @@
+package constructoradjacent
+
+import (
+	"errors"
+	"net/http"
+)
+
+type Server struct {
+	Addr string
+}
+
+// DefaultServer is related to Server, so it can be placed before its constructors.
+var DefaultServer = NewServer(":8080")
+
+const defaultAddr = ":80"
+
+var errNoAddr = errors.New("no address")
+
+func NewServer(addr string) *Server { // want `constructor "NewServer" for struct "Server" should be placed directly after the struct declaration, found const "defaultAddr" in between`
+	return &Server{Addr: addr}
+}
+
+func (s *Server) Start() error {
+	if s.Addr == "" {
+		return errNoAddr
+	}
+
+	return nil
+}
+
+type Client struct{}
+
+func helper() {}
+
+type Other struct{}
+
+func NewClient() *Client { // want `constructor "NewClient" for struct "Client" should be placed directly after the struct declaration, found function "helper" in between`
+	return &Client{}
+}
+
+type (
+	A struct{}
+	B struct{}
+)
+
+func NewA() *A {
+	return &A{}
+}
+
+var zeroB = B{}
+
+// NewB is not adjacent to B, as NewA is the constructor of another type of the same declaration.
+func NewB() *B { // want `constructor "NewB" for struct "B" should be placed directly after the struct declaration, found function "NewA" in between`
+	return &zeroB
+}
+
+type Handler struct{}
+
+// defaultHandler uses the Handler of another package, so it's not related to Handler.
+var defaultHandler = http.Handler(nil)
+
+func NewHandler() *Handler { // want `constructor "NewHandler" for struct "Handler" should be placed directly after the struct declaration, found var "defaultHandler" in between`
+	return &Handler{}
+}
+
+func (h *Handler) ServeHTTP(http.ResponseWriter, *http.Request) {}
+
+type Config struct{}
+
+// defaultConfig uses a local name that shadows Config, so it's not related to Config.
+var defaultConfig = func() int {
+	Config := 1
+
+	return Config
+}()
+
+func NewConfig() *Config { // want `constructor "NewConfig" for struct "Config" should be placed directly after the struct declaration, found var "defaultConfig" in between`
+	return &Config{}
+}
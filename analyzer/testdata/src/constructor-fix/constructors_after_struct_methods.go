@@ -0,0 +1,32 @@
+package constructorfix
+
+type (
+	MyStruct2 struct {
+		Name string
+	}
+)
+
+// GetName returns the name.
+func (m MyStruct2) GetName() string {
+	return m.Name
+}
+
+func (m *MyStruct2) SetName(name string) {
+	m.Name = name
+}
+
+// NewMyStruct2 creates a new MyStruct2.
+//
+//go:noinline
+func NewMyStruct2() *MyStruct2 { // want `constructor "NewMyStruct2" for struct "MyStruct2" should be placed before struct method "GetName"`
+	return &MyStruct2{Name: "John"}
+}
+
+func helper() string {
+	return "helper"
+}
+
+//nolint:gocritic // must constructor.
+func MustMyStruct2() *MyStruct2 { // want `constructor "MustMyStruct2" for struct "MyStruct2" should be placed before struct method "GetName"`
+	return NewMyStruct2()
+}
@@ -0,0 +1,23 @@
+package constructorfix
+
+// NewMyStruct creates a new MyStruct.
+//
+//go:noinline
+func NewMyStruct() *MyStruct { // want `constructor "NewMyStruct" for struct "MyStruct" should be placed after the struct declaration`
+	return &MyStruct{Name: "John"}
+}
+
+//nolint:gocritic // must constructor.
+func MustMyStruct() *MyStruct { // want `constructor "MustMyStruct" for struct "MyStruct" should be placed after the struct declaration`
+	return NewMyStruct()
+} // end of MustMyStruct
+
+// MyStruct is a struct.
+type MyStruct struct {
+	Name string
+}
+
+// GetName returns the name.
+func (m MyStruct) GetName() string {
+	return m.Name
+}
@@ -0,0 +1,17 @@
+package constructorfix
+
+func (m MyStruct3) GetName() string {
+	return m.Name
+}
+
+type MyStruct3 struct {
+	Name string
+}
+
+func (m *MyStruct3) SetName(name string) {
+	m.Name = name
+}
+
+func NewMyStruct3() *MyStruct3 { // want `constructor "NewMyStruct3" for struct "MyStruct3" should be placed before struct method "GetName"`
+	return &MyStruct3{Name: "John"}
+}
@@ -0,0 +1,17 @@
+package constructororderquantified
+
+type Config struct {
+	path string
+}
+
+func NewFromPath(path string) *Config {
+	return &Config{path: path}
+}
+
+func NewConfigFromFile(path string) (*Config, error) { // want `constructor "NewConfigFromFile" for struct "Config" should be placed before constructor "NewFromPath"`
+	return &Config{path: path}, nil
+}
+
+func NewConfigWithDefaults() *Config {
+	return &Config{}
+}
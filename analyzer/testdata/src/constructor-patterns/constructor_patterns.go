@@ -0,0 +1,28 @@
+package constructorpatterns
+
+func OpenConn() *Conn { // want `constructor "OpenConn" for struct "Conn" should be placed after the struct declaration`
+	return &Conn{}
+}
+
+type Conn struct{}
+
+func (c *Conn) Close() error {
+	return nil
+}
+
+func DialConn() *Conn { // want `constructor "DialConn" for struct "Conn" should be placed before struct method "Close"`
+	return &Conn{}
+}
+
+func ParseConn(string) (*Conn, error) { // want `constructor "ParseConn" for struct "Conn" should be placed before struct method "Close"`
+	return &Conn{}, nil
+}
+
+func FromString(string) *Conn { // want `constructor "FromString" for struct "Conn" should be placed before struct method "Close"`
+	return &Conn{}
+}
+
+// NewConn is not a constructor, because the default patterns are overridden.
+func NewConn() *Conn {
+	return &Conn{}
+}
@@ -0,0 +1,25 @@
+package contiguousmethods
+
+type Sorted struct{}
+
+func NewSorted() *Sorted {
+	return &Sorted{}
+}
+
+func (Sorted) Hello() string {
+	return "hello"
+}
+
+func (Sorted) world() string {
+	return "world"
+}
+
+func sortedHelper() string {
+	return "helper"
+}
+
+type Sorted2 struct{}
+
+func (Sorted2) Bye() string {
+	return "bye"
+}
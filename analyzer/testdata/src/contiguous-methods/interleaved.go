@@ -0,0 +1,30 @@
+package contiguousmethods
+
+type (
+	MyStruct  struct{}
+	MyStruct2 struct{}
+)
+
+func NewMyStruct() *MyStruct {
+	return &MyStruct{}
+}
+
+func (MyStruct) Hello() string {
+	return "hello"
+}
+
+func (MyStruct2) Bye() string { // want `method "Bye" should not be placed inside the methods block of struct "MyStruct", between methods "Hello" and "world"`
+	return "bye"
+}
+
+func helper() string { // want `function "helper" should not be placed inside the methods block of struct "MyStruct", between methods "Hello" and "world"` `function "helper" should not be placed inside the methods block of struct "MyStruct2", between methods "Bye" and "later"`
+	return "helper"
+}
+
+func (MyStruct) world() string { // want `method "world" should not be placed inside the methods block of struct "MyStruct2", between methods "Bye" and "later"`
+	return "world"
+}
+
+func (MyStruct2) later() string {
+	return "later"
+}
@@ -0,0 +1,11 @@
+package crossfileperfileexportedfirst
+
+// The constructor of a type declared in another file is not excluded from the exported first check.
+
+func defaultAddr() string { // want `unexported function "defaultAddr" should be placed after the exported function "MustServer"`
+	return "localhost:8080"
+}
+
+func MustServer() *Server {
+	return NewServer(defaultAddr())
+}
@@ -0,0 +1,16 @@
+package crossfileperfile
+
+// Methods and constructors of Server, declared in the file of another type.
+
+func (s *Server) stop() {} // want `unexported method "stop" for struct "Server" should be placed after the exported method "Close"`
+
+func (s *Server) Restart() {
+	s.stop()
+	s.Start()
+}
+
+func MustServer(addr string) *Server { // want `constructor "MustServer" for struct "Server" should be placed before struct method "stop"`
+	return NewServer(addr)
+}
+
+func (s *Server) Close() {}
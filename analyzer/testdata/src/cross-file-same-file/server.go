@@ -0,0 +1,11 @@
+package crossfilesamefile
+
+type Server struct {
+	Addr string
+}
+
+func NewServer(addr string) *Server {
+	return &Server{Addr: addr}
+}
+
+func (s *Server) Start() {}
@@ -0,0 +1,11 @@
+package crossfilesamefile
+
+func MustServer(addr string) *Server { // want `constructor "MustServer" for struct "Server" should be placed in the file of the struct declaration "server.go"`
+	return NewServer(addr)
+}
+
+func (s *Server) Stop() {} // want `method "Stop" for struct "Server" should be placed in the file of the struct declaration "server.go"`
+
+type helper struct{}
+
+func (h helper) run() {}
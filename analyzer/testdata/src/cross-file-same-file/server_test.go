@@ -0,0 +1,9 @@
+package crossfilesamefile
+
+// Methods and constructors declared in a test file are not moved to the file of the struct declaration.
+
+func newTestServer() *Server {
+	return NewServer("localhost:0")
+}
+
+func (s *Server) reset() {}
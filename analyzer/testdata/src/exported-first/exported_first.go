@@ -0,0 +1,44 @@
+package exportedfirst
+
+import "errors"
+
+const maxRetries = 3 // want `unexported const "maxRetries" should be placed after the exported function "Retry"`
+
+var _ error = (*Error)(nil)
+
+type Error struct {
+	msg string
+}
+
+func NewError(msg string) *Error {
+	return &Error{msg: msg}
+}
+
+func newErrorf(msg string) *Error {
+	return &Error{msg: msg}
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+type state int // want `unexported type "state" should be placed after the exported function "Retry"`
+
+func (s state) String() string {
+	return "state"
+}
+
+var (
+	ErrClosed = errors.New("closed")
+	errReset  = errors.New("reset")
+)
+
+func init() {}
+
+func helper() {} // want `unexported function "helper" should be placed after the exported function "Retry"`
+
+func Retry() int {
+	return maxRetries
+}
+
+var lastState state
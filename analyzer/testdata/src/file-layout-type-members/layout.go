@@ -0,0 +1,17 @@
+package filelayouttypemembers
+
+type Server struct {
+	Addr string
+}
+
+type Client struct{}
+
+func (s *Server) Start() {}
+
+func NewServer() *Server { // want `constructor "NewServer" should be placed before method "Start", following the file layout "type, constructor, method, func"`
+	return &Server{}
+}
+
+func NewClient() *Client {
+	return &Client{}
+}
@@ -0,0 +1,19 @@
+package filelayout
+
+import "fmt"
+
+const prefix = "server: "
+
+var defaultAddr = ":8080"
+
+type Server struct {
+	Addr string
+}
+
+func (s Server) String() string {
+	return fmt.Sprint(prefix, s.Addr)
+}
+
+func format(s string) string {
+	return prefix + s
+}
@@ -0,0 +1,13 @@
+package filelayout
+
+type Client struct {
+	Addr string
+}
+
+var defaultClientAddr = ":9090" // want `var "defaultClientAddr" should be placed before type "Client", following the file layout "const, var, type, func"`
+
+func helper() string {
+	return defaultClientAddr
+}
+
+const timeout = 10
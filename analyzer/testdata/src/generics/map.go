@@ -0,0 +1,25 @@
+package generics
+
+func NewMap[K comparable, V any]() Map[K, V] { // want `constructor "NewMap" for struct "Map" should be placed after the struct declaration`
+	return Map[K, V]{items: make(map[K]V)}
+}
+
+type Map[K comparable, V any] struct {
+	items map[K]V
+}
+
+func (m Map[K, V]) get(k K) V { // want `unexported method "get" for struct "Map" should be placed after the exported method "Put"`
+	return m.items[k]
+}
+
+func (m Map[K, V]) Get(k K) V {
+	return m.get(k)
+}
+
+func (m *Map[K, V]) Put(k K, v V) {
+	m.items[k] = v
+}
+
+func MustMap[K comparable, V any]() (Map[K, V], error) { // want `constructor "MustMap" for struct "Map" should be placed before struct method "get"` `constructor "MustMap" for struct "Map" should be placed before constructor "NewMap"`
+	return NewMap[K, V](), nil
+}
@@ -0,0 +1,22 @@
+package generics
+
+func (s *Set[T]) add(v T) { // want `unexported method "add" for struct "Set" should be placed after the exported method "Len"`
+	s.items[v] = struct{}{}
+}
+
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+func (s (*Set[T])) Len() int {
+	return len(s.items)
+}
+
+func NewSet[T comparable](values ...T) *Set[T] { // want `constructor "NewSet" for struct "Set" should be placed before struct method "add"`
+	s := &Set[T]{items: make(map[T]struct{})}
+	for _, v := range values {
+		s.add(v)
+	}
+
+	return s
+}
@@ -0,0 +1,46 @@
+package helperaftercaller
+
+import "strings"
+
+func Format(s string) string {
+	return upper(trim(s))
+}
+
+func trim(s string) string {
+	return trimLeft(strings.TrimRight(s, " "))
+}
+
+func trimLeft(s string) string {
+	return strings.TrimLeft(s, " ")
+}
+
+func upper(s string) string {
+	return strings.ToUpper(s)
+}
+
+func Title(s string) string {
+	return lower(s) + shared(s)
+}
+
+func Name(s string) string {
+	return shared(s)
+}
+
+// shared has two callers, so it can be placed anywhere.
+func shared(s string) string {
+	return s
+}
+
+func lower(s string) string { // want `function "lower" should be placed right after its only caller function "Title"`
+	return strings.ToLower(s)
+}
+
+type Parser struct{}
+
+func (p *Parser) next() int { // want `method "next" should be placed right after its only caller method "Parse"`
+	return 0
+}
+
+func (p *Parser) Parse() int {
+	return p.next()
+}
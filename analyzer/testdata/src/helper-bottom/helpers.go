@@ -0,0 +1,19 @@
+package helperbottom
+
+import "strings"
+
+func Format(s string) string {
+	return trim(s)
+}
+
+func trim(s string) string { // want `unexported function "trim" should be placed after the exported function "Title"`
+	return strings.TrimSpace(s)
+}
+
+func Title(s string) string {
+	return strings.ToTitle(s)
+}
+
+func lower(s string) string {
+	return strings.ToLower(s)
+}
@@ -0,0 +1,32 @@
+package adapters
+
+import "interface-facts/ports"
+
+var _ ports.Repository = (*MemoryRepository)(nil)
+
+type MemoryRepository struct {
+	values map[string]string
+}
+
+func (r *MemoryRepository) Find(id string) (string, error) {
+	return r.values[id], nil
+}
+
+func (r *MemoryRepository) List() []string {
+	ids := make([]string, 0, len(r.values))
+	for id := range r.values {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func (r *MemoryRepository) Save(id, value string) error { // want `method "Save" for struct "MemoryRepository" should be placed before method "Find", following the interface "interface-facts/ports.Repository"`
+	r.values[id] = value
+	return nil
+}
+
+func (r *MemoryRepository) Delete(id string) error {
+	delete(r.values, id)
+	return nil
+}
@@ -0,0 +1,12 @@
+package ports
+
+type Reader interface {
+	Find(id string) (string, error)
+	List() []string
+}
+
+type Repository interface {
+	Save(id, value string) error
+	Reader
+	Delete(id string) error
+}
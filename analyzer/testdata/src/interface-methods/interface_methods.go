@@ -0,0 +1,66 @@
+package interfacemethods
+
+import "io"
+
+var _ io.ReadWriteCloser = (*File)(nil)
+
+type Shape interface {
+	Area() float64
+	Perimeter() float64
+	Name() string
+}
+
+type Square struct {
+	side float64
+}
+
+func (s Square) Name() string { // want `method "Name" for struct "Square" should be placed after method "Perimeter", following the interface "Shape"`
+	return "square"
+}
+
+func (s Square) Area() float64 {
+	return s.side * s.side
+}
+
+func (s Square) Perimeter() float64 {
+	return 4 * s.side
+}
+
+type Circle struct {
+	radius float64
+}
+
+func (c Circle) Area() float64 {
+	return 3 * c.radius * c.radius
+}
+
+func (c Circle) Perimeter() float64 {
+	return 6 * c.radius
+}
+
+func (c Circle) Name() string {
+	return "circle"
+}
+
+func (c Circle) Diameter() float64 {
+	return 2 * c.radius
+}
+
+type File struct{}
+
+func (f *File) Read(p []byte) (int, error) {
+	return 0, nil
+}
+
+func (f *File) Write(p []byte) (int, error) { // want `method "Write" for struct "File" should not be placed between the methods of the interface "io.ReadCloser"`
+	return len(p), nil
+}
+
+// the interfaces of the imported packages are checked too, "io.ReadCloser" and "io.WriteCloser".
+func (f *File) Size() int { // want `interface "io.ReadCloser"` `interface "io.ReadWriteCloser"` `interface "io.WriteCloser"`
+	return 0
+}
+
+func (f *File) Close() error {
+	return nil
+}
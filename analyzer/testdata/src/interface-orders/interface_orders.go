@@ -0,0 +1,26 @@
+package interfaceorders
+
+import "io"
+
+type Shape interface { // want Shape:`interfaceMethods\(Area, Perimeter, Name\)`
+	Area() float64
+	Perimeter() float64
+	Name() string
+}
+
+// the methods of an embedded interface are placed where it's embedded.
+type NamedCloser interface { // want NamedCloser:`interfaceMethods\(Name, Close, Open\)`
+	Name() string
+	io.Closer
+	Open() error
+}
+
+type Solid interface { // want Solid:`interfaceMethods\(Volume, Area, Perimeter, Name\)`
+	Volume() float64
+	Shape
+}
+
+// the type constraints are exported too, but not checked by the interface-methods check.
+type Number interface { // want Number:`interfaceMethods\(\)`
+	~int | ~float64
+}
@@ -0,0 +1,34 @@
+// Package adapters doesn't import the packages of the configured interfaces, only the service that imports them.
+package adapters // want `interface "interface-transitive/ports.Missing" of the interfaces setting not found in the package or its dependencies`
+
+import "interface-transitive/service"
+
+var _ = service.NewService
+
+type MemoryRepository struct {
+	values map[string]string
+}
+
+func (r *MemoryRepository) Find(id string) (string, error) {
+	return r.values[id], nil
+}
+
+func (r *MemoryRepository) Save(id, value string) error { // want `method "Save" for struct "MemoryRepository" should be placed before method "Find", following the interface "interface-transitive/ports.Repository"`
+	r.values[id] = value
+
+	return nil
+}
+
+type File struct{}
+
+func (f *File) Read(p []byte) (int, error) {
+	return 0, nil
+}
+
+func (f *File) Close() error {
+	return nil
+}
+
+func (f *File) Write(p []byte) (int, error) { // want `method "Write" for struct "File" should be placed after method "Read", following the interface "io.ReadWriteCloser"`
+	return len(p), nil
+}
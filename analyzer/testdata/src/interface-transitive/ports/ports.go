@@ -0,0 +1,6 @@
+package ports // want `interface "io.ReadWriteCloser" of the interfaces setting not found in the package or its dependencies` `interface "interface-transitive/ports.Missing" of the interfaces setting not found in the package or its dependencies`
+
+type Repository interface {
+	Save(id, value string) error
+	Find(id string) (string, error)
+}
@@ -0,0 +1,16 @@
+package service // want `interface "interface-transitive/ports.Missing" of the interfaces setting not found in the package or its dependencies`
+
+import (
+	"io"
+
+	"interface-transitive/ports"
+)
+
+type Service struct {
+	repository ports.Repository
+	log        io.Writer
+}
+
+func NewService(repository ports.Repository, log io.Writer) *Service {
+	return &Service{repository: repository, log: log}
+}
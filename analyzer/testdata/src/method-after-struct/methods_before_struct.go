@@ -0,0 +1,22 @@
+package methodafterstruct
+
+// String returns the name.
+func (m MyStruct) String() string { // want `method "String" for struct "MyStruct" should be placed after the struct declaration`
+	return m.Name
+}
+
+func (m *MyStruct) reset() { // want `method "reset" for struct "MyStruct" should be placed after the struct declaration`
+	m.Name = ""
+}
+
+type MyStruct struct {
+	Name string
+}
+
+func NewMyStruct() *MyStruct {
+	return &MyStruct{}
+}
+
+func (m *MyStruct) SetName(name string) {
+	m.Name = name
+}
@@ -0,0 +1,11 @@
+package methodafterstruct
+
+func (o Other) Print() {} // want `method "Print" for struct "Other" should be placed after the struct declaration`
+
+type (
+	Other struct{}
+)
+
+func NewOther() Other {
+	return Other{}
+}
@@ -0,0 +1,15 @@
+package namecomparatorcaseinsensitive
+
+func Get10() {}
+
+func Get2() {}
+
+func Id() {}
+
+func URL() {}
+
+func Value() {} // want `function "Value" should be placed after function "UUID"`
+
+func Url() {}
+
+func UUID() {}
@@ -0,0 +1,21 @@
+package namecomparatornatural
+
+type Client struct{}
+
+func (c Client) Get2() {}
+
+func (c Client) Get10() {}
+
+func (c Client) GetURL() {}
+
+func (c Client) GetURLPath() {}
+
+func (c Client) Getaway() {}
+
+func (c Client) ID() {}
+
+func (c Client) HTTPServer() {} // want `method "HTTPServer" for struct "Client" should be placed after method "Getaway"`
+
+func (c Client) Version3() {}
+
+func (c Client) Version1() {} // want `method "Version1" for struct "Client" should be placed after method "ID"`
@@ -0,0 +1,22 @@
+package receiverkindpointerfirst
+
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+func (s *Set[T]) Add(item T) {
+	s.items[item] = struct{}{}
+}
+
+func (s Set[T]) Contains(item T) bool { // want `value receiver method "Contains" for struct "Set" should be placed after the pointer receiver method "Remove"`
+	_, ok := s.items[item]
+	return ok
+}
+
+func (s (*Set[T])) Remove(item T) {
+	delete(s.items, item)
+}
+
+func (s Set[T]) Len() int {
+	return len(s.items)
+}
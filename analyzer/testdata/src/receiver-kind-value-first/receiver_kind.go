@@ -0,0 +1,25 @@
+package receiverkindvaluefirst
+
+type MyStruct struct {
+	Name string
+}
+
+func (m *MyStruct) SetName(name string) { // want `pointer receiver method "SetName" for struct "MyStruct" should be placed after the value receiver method "GetName"`
+	m.Name = name
+}
+
+func (m MyStruct) GetName() string {
+	return m.Name
+}
+
+func (m *MyStruct) Reset() { // want `method "Reset" for struct "MyStruct" should be placed before method "SetName"`
+	m.Name = ""
+}
+
+func (m *MyStruct) validate() bool { // want `pointer receiver method "validate" for struct "MyStruct" should be placed after the value receiver method "size"`
+	return m.Name != ""
+}
+
+func (m MyStruct) size() int {
+	return len(m.Name)
+}
@@ -0,0 +1,24 @@
+package stepdowncalleefirst
+
+import "strings"
+
+func trim(s string) string {
+	return strings.TrimSpace(s)
+}
+
+func Format(s string) string {
+	return upper(trim(s))
+}
+
+// upper is placed before its last caller.
+func upper(s string) string {
+	return strings.ToUpper(s)
+}
+
+func Title(s string) string {
+	return upper(s) + lower(s)
+}
+
+func lower(s string) string { // want `function "lower" should be placed before its caller function "Title"`
+	return strings.ToLower(s)
+}
@@ -0,0 +1,65 @@
+package stepdowncallerfirst
+
+import "strings"
+
+func trim(s string) string { // want `function "trim" should be placed after its caller function "Format"`
+	return strings.TrimSpace(s)
+}
+
+func Format(s string) string {
+	return upper(trim(s))
+}
+
+func upper(s string) string {
+	return strings.ToUpper(s)
+}
+
+type Parser struct{}
+
+func (p *Parser) next() int { // want `method "next" should be placed after its caller method "Parse"` `unexported method "next" for struct "Parser" should be placed after the exported method "Parse"`
+	return 0
+}
+
+func (p *Parser) Parse() int {
+	return p.next() + p.peek()
+}
+
+func (p *Parser) peek() int {
+	return 0
+}
+
+// isEven and isOdd call each other, the call from isOdd to isEven closes the cycle and is ignored.
+func isEven(n int) bool {
+	if n == 0 {
+		return true
+	}
+
+	return isOdd(n - 1)
+}
+
+func isOdd(n int) bool {
+	if n == 0 {
+		return false
+	}
+
+	return isEven(n - 1)
+}
+
+// helper is not called by Shadowed, that calls its own helper.
+func helper() int {
+	return 0
+}
+
+func Shadowed() int {
+	helper := func() int { return 1 }
+
+	return helper()
+}
+
+func identity[T any](v T) T { // want `function "identity" should be placed after its caller function "Generic"`
+	return v
+}
+
+func Generic() int {
+	return identity[int](1)
+}
@@ -0,0 +1,29 @@
+package structmethodfix
+
+type Greetings struct{}
+
+// hello is unexported.
+func (m Greetings) hello() string { // want `unexported method "hello" for struct "Greetings" should be placed after the exported method "GoodAfternoon"`
+	return "hello"
+}
+
+// GoodMorning says good morning.
+//
+//go:noinline
+func (m Greetings) GoodMorning() string {
+	return "good morning"
+}
+
+// unrelated is not a method, it stays where it is.
+func unrelated() string {
+	return "unrelated"
+}
+
+//nolint:unparam // always the same.
+func (m *Greetings) GoodAfternoon(name string) string { // want `method "GoodAfternoon" for struct "Greetings" should be placed before method "GoodMorning"`
+	return "good afternoon " + name
+} // end of GoodAfternoon
+
+func (m *Greetings) bye(name string) string { // want `method "bye" for struct "Greetings" should be placed before method "hello"`
+	return "bye " + name
+}
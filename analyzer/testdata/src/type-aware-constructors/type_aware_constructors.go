@@ -0,0 +1,34 @@
+package typeawareconstructors
+
+import (
+	. "time"
+)
+
+// Alias is an alias of Server, constructors returning it are constructors of Server.
+type Alias = Server
+
+func ParseServer(addr string) (*Server, error) { // want `constructor "ParseServer" for struct "Server" should be placed after the struct declaration`
+	return &Server{Addr: addr}, nil
+}
+
+type Server struct {
+	Addr string
+}
+
+func (s *Server) Start() error {
+	return nil
+}
+
+func NewAlias() (x Alias) { // want `constructor "NewAlias" for struct "Server" should be placed before struct method "Start"`
+	return Server{}
+}
+
+// NewDuration returns a type declared in another package, so it's not a constructor.
+func NewDuration() Duration {
+	return Second
+}
+
+// ServerName returns a string, so it's not a constructor.
+func ServerName() string {
+	return "server"
+}
@@ -0,0 +1,35 @@
+package typeorder
+
+type (
+	Server struct{}
+	state  int // want `unexported type "state" should be placed after the exported type "Client"`
+)
+
+// the types declared inside a function are not checked.
+func run() {
+	type zlocal struct{}
+
+	_ = zlocal{}
+}
+
+type Client struct{} // want `type "Client" should be placed before type "Server"`
+
+func NewServer() *Server {
+	return &Server{}
+}
+
+func (s *Server) Start() {}
+
+func NewClient() *Client { // want `constructors and methods of struct "Client" should be placed after the ones of struct "state", following the order of the type declarations`
+	return &Client{}
+}
+
+func (s state) String() string {
+	return "state"
+}
+
+func (c *Client) Close() {}
+
+type zone struct{}
+
+func (z zone) apply() {}
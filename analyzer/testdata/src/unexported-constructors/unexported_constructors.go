@@ -0,0 +1,31 @@
+package unexportedconstructors
+
+func newServer() *server { // want `constructor "newServer" for struct "server" should be placed after the struct declaration`
+	return &server{}
+}
+
+type server struct {
+	addr string
+}
+
+func (s *server) start() error {
+	return nil
+}
+
+func mustServer() *server { // want `constructor "mustServer" for struct "server" should be placed before struct method "start"`
+	return newServer()
+}
+
+type Client struct{}
+
+func newTestClient() *Client { // want `unexported constructor "newTestClient" for struct "Client" should be placed after the exported constructor "NewClient"`
+	return &Client{}
+}
+
+func NewClient() *Client {
+	return &Client{}
+}
+
+func (c *Client) Do() {}
+
+func Exported() {}
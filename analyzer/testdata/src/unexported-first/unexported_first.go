@@ -0,0 +1,27 @@
+package unexportedfirst
+
+type MyStruct struct {
+	Name string
+}
+
+func NewMyStruct() *MyStruct {
+	return &MyStruct{}
+}
+
+func (m *MyStruct) GetName() string { // want `exported method "GetName" for struct "MyStruct" should be placed after the unexported method "validate"`
+	return m.Name
+}
+
+func (m *MyStruct) normalize() {
+	m.Name = ""
+}
+
+func (m *MyStruct) validate() bool {
+	return m.Name != ""
+}
+
+func Exported() {} // want `exported function "Exported" should be placed after the unexported function "helper"`
+
+func helper() {}
+
+func Other() {}
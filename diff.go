@@ -0,0 +1,183 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines shown around each change in a unified diff.
+const contextLines = 3
+
+// diffLine is a line of a diff, with its kind: ' ' unchanged, '-' deleted and '+' inserted.
+type diffLine struct {
+	kind byte
+	text string
+}
+
+// unifiedDiff returns the unified diff between the old and the new content of the file.
+func unifiedDiff(filename string, oldContent, newContent []byte) string {
+	lines := diffLines(splitLines(string(oldContent)), splitLines(string(newContent)))
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "--- %s (old)\n+++ %s (new)\n", filename, filename)
+
+	for start := 0; start < len(lines); {
+		first := slices.IndexFunc(lines[start:], isChange)
+		if first < 0 {
+			break
+		}
+
+		first += start
+
+		// extend the hunk while the next change is close enough to share the context lines.
+		last := first
+		for i := first + 1; i < len(lines) && i-last-1 <= 2*contextLines; i++ {
+			if isChange(lines[i]) {
+				last = i
+			}
+		}
+
+		from, to := max(first-contextLines, 0), min(last+contextLines+1, len(lines))
+		writeHunk(&sb, lines, from, to)
+
+		start = to
+	}
+
+	return sb.String()
+}
+
+func writeHunk(sb *strings.Builder, lines []diffLine, from, to int) {
+	oldStart, newStart := 1, 1
+
+	for _, l := range lines[:from] {
+		if l.kind != '+' {
+			oldStart++
+		}
+
+		if l.kind != '-' {
+			newStart++
+		}
+	}
+
+	var oldLen, newLen int
+
+	for _, l := range lines[from:to] {
+		if l.kind != '+' {
+			oldLen++
+		}
+
+		if l.kind != '-' {
+			newLen++
+		}
+	}
+
+	// an empty range starts at the line before it.
+	if oldLen == 0 {
+		oldStart--
+	}
+
+	if newLen == 0 {
+		newStart--
+	}
+
+	fmt.Fprintf(sb, "@@ -%d,%d +%d,%d @@\n", oldStart, oldLen, newStart, newLen)
+
+	for _, l := range lines[from:to] {
+		sb.WriteByte(l.kind)
+		sb.WriteString(l.text)
+
+		if !strings.HasSuffix(l.text, "\n") {
+			sb.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// diffLines returns the shortest edit script that transforms a into b, using Myers' algorithm.
+func diffLines(a, b []string) []diffLine {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+
+	var trace [][]int
+
+search:
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, slices.Clone(v))
+
+		for k := -d; k <= d; k += 2 {
+			x := v[offset+k-1] + 1
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			}
+
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	var lines []diffLine
+
+	x, y := n, m
+
+	for d := len(trace) - 1; d > 0; d-- {
+		v = trace[d]
+		k := x - y
+
+		prevK := k - 1
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		}
+
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			lines = append(lines, diffLine{kind: ' ', text: a[x]})
+		}
+
+		if x == prevX {
+			y--
+			lines = append(lines, diffLine{kind: '+', text: b[y]})
+		} else {
+			x--
+			lines = append(lines, diffLine{kind: '-', text: a[x]})
+		}
+	}
+
+	for x > 0 && y > 0 {
+		x--
+		y--
+		lines = append(lines, diffLine{kind: ' ', text: a[x]})
+	}
+
+	slices.Reverse(lines)
+
+	return lines
+}
+
+func isChange(l diffLine) bool {
+	return l.kind != ' '
+}
+
+// splitLines splits the content in lines, keeping the new line characters.
+func splitLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
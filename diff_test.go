@@ -0,0 +1,80 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		oldContent string
+		newContent string
+		want       string
+	}{
+		{
+			desc:       "equal",
+			oldContent: "a\nb\n",
+			newContent: "a\nb\n",
+			want:       "--- f.go (old)\n+++ f.go (new)\n",
+		},
+		{
+			desc:       "changed line",
+			oldContent: "a\nb\nc\n",
+			newContent: "a\nB\nc\n",
+			want: "--- f.go (old)\n+++ f.go (new)\n" +
+				"@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n",
+		},
+		{
+			desc:       "no trailing new line",
+			oldContent: "a\nb",
+			newContent: "a\nc",
+			want: "--- f.go (old)\n+++ f.go (new)\n" +
+				"@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n\\ No newline at end of file\n",
+		},
+		{
+			desc:       "new line added at end of file",
+			oldContent: "a",
+			newContent: "a\n",
+			want: "--- f.go (old)\n+++ f.go (new)\n" +
+				"@@ -1,1 +1,1 @@\n-a\n\\ No newline at end of file\n+a\n",
+		},
+		{
+			desc:       "empty old content",
+			oldContent: "",
+			newContent: "a\nb\n",
+			want: "--- f.go (old)\n+++ f.go (new)\n" +
+				"@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			desc:       "empty new content",
+			oldContent: "a\nb\n",
+			newContent: "",
+			want: "--- f.go (old)\n+++ f.go (new)\n" +
+				"@@ -1,2 +0,0 @@\n-a\n-b\n",
+		},
+		{
+			desc:       "separate hunks",
+			oldContent: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			newContent: "0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n",
+			want: "--- f.go (old)\n+++ f.go (new)\n" +
+				"@@ -1,3 +1,4 @@\n+0\n 1\n 2\n 3\n" +
+				"@@ -9,4 +10,3 @@\n 9\n 10\n 11\n-12\n",
+		},
+		{
+			desc:       "close changes in one hunk",
+			oldContent: "1\n2\n3\n4\n5\n6\n7\n",
+			newContent: "0\n1\n2\n3\n4\n5\n6\n",
+			want: "--- f.go (old)\n+++ f.go (new)\n" +
+				"@@ -1,7 +1,7 @@\n+0\n 1\n 2\n 3\n 4\n 5\n 6\n-7\n",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			got := unifiedDiff("f.go", []byte(test.oldContent), []byte(test.newContent))
+			if got != test.want {
+				t.Errorf("unexpected diff\n-- got --\n%s\n-- want --\n%s", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package internal
+
+import (
+	"cmp"
+	"go/ast"
+	"go/types"
+	"slices"
+)
+
+// callGraph holds the calls between the functions and methods declared in a file,
+// the calls to the top-level functions and to the methods of the same receiver.
+type callGraph struct {
+	// the functions and methods of the file, in source order
+	funcs []*ast.FuncDecl
+
+	// the functions called by each function, in the order of their first call
+	callees map[*ast.FuncDecl][]*ast.FuncDecl
+}
+
+// newCallGraph builds the call graph of the functions and methods of the file.
+// The type checker information, if available, is used to ignore the calls to shadowed names.
+func newCallGraph(funcDecls []*ast.FuncDecl, info *types.Info) *callGraph {
+	g := &callGraph{
+		funcs:   slices.Clone(funcDecls),
+		callees: make(map[*ast.FuncDecl][]*ast.FuncDecl),
+	}
+
+	slices.SortFunc(g.funcs, func(a, b *ast.FuncDecl) int {
+		return cmp.Compare(a.Pos(), b.Pos())
+	})
+
+	funcs := make(map[string]*ast.FuncDecl)
+	methods := make(map[[2]string]*ast.FuncDecl)
+
+	for _, fn := range g.funcs {
+		if fn.Recv == nil {
+			funcs[fn.Name.Name] = fn
+		} else if recv := funcIsMethod(fn); recv != nil {
+			methods[[2]string{recv.Name, fn.Name.Name}] = fn
+		}
+	}
+
+	for _, caller := range g.funcs {
+		if caller.Body == nil {
+			continue
+		}
+
+		recvName, recvType := receiver(caller)
+
+		ast.Inspect(caller.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			var (
+				callee *ast.FuncDecl
+				ident  *ast.Ident
+				decl   *ast.Ident
+			)
+
+			switch fun := unwrapFunc(call.Fun).(type) {
+			case *ast.Ident:
+				callee, ident = funcs[fun.Name], fun
+				if callee != nil {
+					decl = callee.Name
+				}
+
+			case *ast.SelectorExpr:
+				x, isIdent := fun.X.(*ast.Ident)
+				if isIdent && recvName != nil && x.Name == recvName.Name {
+					callee, ident, decl = methods[[2]string{recvType, fun.Sel.Name}], x, recvName
+				}
+			}
+
+			if callee == nil || callee == caller || slices.Contains(g.callees[caller], callee) {
+				return true
+			}
+
+			if info != nil {
+				if obj := info.Uses[ident]; obj == nil || obj.Pos() != decl.Pos() {
+					return true
+				}
+			}
+
+			g.callees[caller] = append(g.callees[caller], callee)
+
+			return true
+		})
+	}
+
+	return g
+}
+
+// callers returns the functions that call fn, in source order.
+func (g *callGraph) callers(fn *ast.FuncDecl) []*ast.FuncDecl {
+	var callers []*ast.FuncDecl
+
+	for _, caller := range g.funcs {
+		if slices.Contains(g.callees[caller], fn) {
+			callers = append(callers, caller)
+		}
+	}
+
+	return callers
+}
+
+// acyclic returns the call graph without the calls that close a cycle.
+// The functions are visited depth first in source order, and their callees in the order of their first call,
+// so the same calls are always removed.
+func (g *callGraph) acyclic() *callGraph {
+	out := &callGraph{
+		funcs:   g.funcs,
+		callees: make(map[*ast.FuncDecl][]*ast.FuncDecl),
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	state := make(map[*ast.FuncDecl]int)
+
+	var visit func(fn *ast.FuncDecl)
+	visit = func(fn *ast.FuncDecl) {
+		state[fn] = visiting
+
+		for _, callee := range g.callees[fn] {
+			if state[callee] == visiting {
+				continue
+			}
+
+			out.callees[fn] = append(out.callees[fn], callee)
+
+			if state[callee] == 0 {
+				visit(callee)
+			}
+		}
+
+		state[fn] = visited
+	}
+
+	for _, fn := range g.funcs {
+		if state[fn] == 0 {
+			visit(fn)
+		}
+	}
+
+	return out
+}
+
+// receiver returns the name of the receiver of the method, and the name of its type.
+func receiver(fn *ast.FuncDecl) (*ast.Ident, string) {
+	recvType := funcIsMethod(fn)
+	if recvType == nil || len(fn.Recv.List[0].Names) == 0 {
+		return nil, ""
+	}
+
+	name := fn.Recv.List[0].Names[0]
+	if name.Name == "_" {
+		return nil, ""
+	}
+
+	return name, recvType.Name
+}
+
+// unwrapFunc returns the called expression without parentheses and type arguments, e.g. `f` for `(f[int])`.
+func unwrapFunc(expr ast.Expr) ast.Expr {
+	for {
+		switch e := ast.Unparen(expr).(type) {
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.IndexListExpr:
+			expr = e.X
+		default:
+			return e
+		}
+	}
+}
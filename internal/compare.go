@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"cmp"
+	"strings"
+	"unicode"
+)
+
+// NameComparator is how the names are compared by the alphabetical checks.
+type NameComparator string
+
+const (
+	// BytewiseComparator compares the names byte by byte, so the uppercase names go before the lowercase ones.
+	BytewiseComparator NameComparator = "bytewise"
+	// CaseInsensitiveComparator compares the names ignoring the case.
+	CaseInsensitiveComparator NameComparator = "case-insensitive"
+	// NaturalComparator compares the names word by word, ignoring the case, and the numbers by their value,
+	// e.g. `Get2` before `Get10`, and `GetURL` before `GetURLPath` and `Getaway`.
+	NaturalComparator NameComparator = "natural"
+)
+
+// Compare compares the names, ties are broken byte by byte so the order is always the same.
+func (c NameComparator) Compare(a, b string) int {
+	switch c {
+	case CaseInsensitiveComparator:
+		if r := cmp.Compare(strings.ToLower(a), strings.ToLower(b)); r != 0 {
+			return r
+		}
+
+	case NaturalComparator:
+		if r := compareWords(splitWords(a), splitWords(b)); r != 0 {
+			return r
+		}
+
+	case BytewiseComparator:
+	}
+
+	return cmp.Compare(a, b)
+}
+
+// compareWords compares the words one by one, the numbers by their value and the other words ignoring the case.
+func compareWords(a, b []string) int {
+	for i := range min(len(a), len(b)) {
+		if r := compareWord(a[i], b[i]); r != 0 {
+			return r
+		}
+	}
+
+	return cmp.Compare(len(a), len(b))
+}
+
+func compareWord(a, b string) int {
+	aNumber, bNumber := isNumber(a), isNumber(b)
+
+	switch {
+	case aNumber && bNumber:
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		if r := cmp.Compare(len(a), len(b)); r != 0 {
+			return r
+		}
+
+		return cmp.Compare(a, b)
+
+	case aNumber:
+		return -1
+
+	case bNumber:
+		return 1
+
+	default:
+		return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+	}
+}
+
+// splitWords splits the name in words, e.g. `getHTTPServer2` in `get`, `HTTP`, `Server` and `2`.
+// The underscores are ignored.
+func splitWords(name string) []string {
+	runes := []rune(name)
+
+	var (
+		words []string
+		start int
+	)
+
+	for i := 1; i <= len(runes); i++ {
+		if i < len(runes) && !isWordBoundary(runes, i) {
+			continue
+		}
+
+		if word := strings.Trim(string(runes[start:i]), "_"); word != "" {
+			words = append(words, word)
+		}
+
+		start = i
+	}
+
+	return words
+}
+
+// isWordBoundary checks whether a new word starts at the rune i,
+// e.g. `Server` in `getServer`, `2` in `get2`, and `Server` in `HTTPServer`.
+func isWordBoundary(runes []rune, i int) bool {
+	prev, curr := runes[i-1], runes[i]
+
+	switch {
+	case curr == '_' || prev == '_':
+		return true
+	case unicode.IsDigit(prev) != unicode.IsDigit(curr):
+		return true
+	case unicode.IsLower(prev) && unicode.IsUpper(curr):
+		return true
+	case unicode.IsUpper(prev) && unicode.IsUpper(curr):
+		return i+1 < len(runes) && unicode.IsLower(runes[i+1])
+	default:
+		return false
+	}
+}
+
+func isNumber(word string) bool {
+	for _, r := range word {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return word != ""
+}
@@ -0,0 +1,167 @@
+package internal
+
+import (
+	"cmp"
+	"go/ast"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// analyzeConstructorOrder checks that the constructors follow the order of the constructor groups,
+// alphabetically within a group if enabled, and that a constructor wrapping another one, e.g. `MustX` calling `NewX`,
+// is placed directly after it.
+func (sh *StructHolder) analyzeConstructorOrder(pass *analysis.Pass, constructors []*ast.FuncDecl) {
+	var (
+		previous *ast.FuncDecl
+		groups   = make([][]*ast.FuncDecl, len(sh.ConstructorOrder)+1)
+	)
+
+	for i, c := range constructors {
+		if wrapped := sh.wrappedConstructor(c); wrapped != nil {
+			if i == 0 || !sh.wraps(constructors[i-1], wrapped) {
+				reportWrapperConstructorNotAfterWrapped(pass, sh.Struct, c, wrapped)
+			}
+
+			continue
+		}
+
+		group := sh.constructorGroup(c)
+		if previous != nil && sh.constructorGroup(previous) > group {
+			reportConstructorGroupNotSorted(pass, sh.Struct, previous, c)
+		}
+
+		groups[group] = append(groups[group], c)
+		previous = c
+	}
+
+	if !sh.Features.IsEnabled(AlphabeticalConstructors) {
+		return
+	}
+
+	for _, group := range groups {
+		for _, m := range misplacements(group, sh.compareNames) {
+			reportConstructorNotSortedAlphabetically(pass, sh.Struct, m.item, m.neighbour, m.after)
+		}
+	}
+}
+
+// sortedConstructorsByGroup returns the constructors sorted by group, and by compare within a group,
+// with each wrapping constructor placed directly after the constructor it wraps.
+func (sh *StructHolder) sortedConstructorsByGroup(
+	constructors []*ast.FuncDecl,
+	compare func(a, b *ast.FuncDecl) int,
+) []*ast.FuncDecl {
+	var (
+		sorted   []*ast.FuncDecl
+		wrappers = make(map[*ast.FuncDecl][]*ast.FuncDecl)
+	)
+
+	for _, c := range constructors {
+		if wrapped := sh.wrappedConstructor(c); wrapped != nil {
+			wrappers[wrapped] = append(wrappers[wrapped], c)
+		} else {
+			sorted = append(sorted, c)
+		}
+	}
+
+	slices.SortStableFunc(sorted, func(a, b *ast.FuncDecl) int {
+		return cmp.Or(cmp.Compare(sh.constructorGroup(a), sh.constructorGroup(b)), compare(a, b))
+	})
+
+	out := make([]*ast.FuncDecl, 0, len(constructors))
+
+	var appendWithWrappers func(c *ast.FuncDecl)
+	appendWithWrappers = func(c *ast.FuncDecl) {
+		out = append(out, c)
+		for _, w := range wrappers[c] {
+			appendWithWrappers(w)
+		}
+	}
+
+	for _, c := range sorted {
+		appendWithWrappers(c)
+	}
+
+	return out
+}
+
+// constructorGroup returns the index of the group with the longest match for the constructor name,
+// or the number of groups if it doesn't match any of them.
+func (sh *StructHolder) constructorGroup(c *ast.FuncDecl) int {
+	group, longest := len(sh.ConstructorOrder), 0
+
+	for i, pattern := range sh.ConstructorOrder {
+		if loc := pattern.FindStringIndex(c.Name.Name); loc != nil && loc[0] == 0 && loc[1] > longest {
+			group, longest = i, loc[1]
+		}
+	}
+
+	return group
+}
+
+// wrappedConstructor returns the constructor of the struct that is called by the constructor, e.g. `NewX` for `MustX`,
+// or nil if it doesn't call another constructor of the struct with the same visibility.
+// Constructors calling each other in a cycle don't wrap any constructor.
+func (sh *StructHolder) wrappedConstructor(c *ast.FuncDecl) *ast.FuncDecl {
+	wrapped := sh.calledConstructor(c)
+
+	visited := map[*ast.FuncDecl]bool{c: true}
+	for next := wrapped; next != nil; next = sh.calledConstructor(next) {
+		if visited[next] {
+			if next == c {
+				return nil
+			}
+
+			break
+		}
+
+		visited[next] = true
+	}
+
+	return wrapped
+}
+
+// wraps checks whether the constructor is, or wraps directly or through other constructors, the target constructor.
+func (sh *StructHolder) wraps(c, target *ast.FuncDecl) bool {
+	for next := c; next != nil; next = sh.wrappedConstructor(next) {
+		if next == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// calledConstructor returns the first constructor of the struct, with the same visibility, called by the constructor.
+func (sh *StructHolder) calledConstructor(c *ast.FuncDecl) *ast.FuncDecl {
+	if c.Body == nil {
+		return nil
+	}
+
+	var called *ast.FuncDecl
+
+	ast.Inspect(c.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || called != nil {
+			return called == nil
+		}
+
+		ident := getIdent(call.Fun)
+		if ident == nil {
+			return true
+		}
+
+		for _, other := range sh.Constructors {
+			if other != c && other.Name.Name == ident.Name && other.Name.IsExported() == c.Name.IsExported() {
+				called = other
+
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return called
+}
@@ -3,11 +3,20 @@ package internal
 const (
 	ConstructorCheck Feature = 1 << iota
 	StructMethodCheck
-	AlphabeticalCheck
+	AlphabeticalConstructors
+	AlphabeticalMethods
+	AlphabeticalFunctions
+	AlphabeticalTypes
 	FunctionCheck
+	TypeAwareConstructors
+	MethodAfterStructCheck
+	ContiguousMethodsCheck
+	ExportedFirstCheck
+	TypeOrderCheck
+	InterfaceMethodsCheck
 )
 
-type Feature uint8
+type Feature uint16
 
 func (f *Feature) Enable(other Feature) {
 	*f |= other
@@ -2,6 +2,11 @@ package internal
 
 import (
 	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"slices"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
 )
@@ -9,46 +14,129 @@ import (
 // FileProcessor Holder to store all the functions that are potential to be constructors and all the structs.
 type FileProcessor struct {
 	structs       map[string]*StructHolder
-	features      Feature
+	settings      Settings
 	topLevelFuncs []*ast.FuncDecl
+	funcDecls     []*ast.FuncDecl
+	file          *ast.File
+
+	// type checker information, used to detect constructors if TypeAwareConstructors is enabled
+	pkg       *types.Package
+	typesInfo *types.Info
+
+	// the types declared in all the files of the package, used by the cross-file policies
+	packageTypes map[string]*ast.TypeSpec
+
+	// the interfaces whose methods are kept together, used by the InterfaceMethodsCheck
+	interfaces []orderedInterface
 }
 
 // NewFileProcessor creates a new file processor.
-func NewFileProcessor(checkers Feature) *FileProcessor {
+func NewFileProcessor(settings Settings) *FileProcessor {
 	return &FileProcessor{
 		structs:  make(map[string]*StructHolder),
-		features: checkers,
+		settings: settings,
 	}
 }
 
 // Analyze check whether the order of the methods in the constructor is correct.
 func (fp *FileProcessor) Analyze(pass *analysis.Pass) {
-	for _, sh := range fp.structs {
-		// filter out structs that are not declared inside that file
-		if sh.Struct != nil {
-			sh.Analyze(pass)
+	for name, sh := range fp.structs {
+		// the structs that are not declared inside that file are only checked by the cross-file policy
+		if sh.Struct == nil {
+			fp.analyzeCrossFile(pass, name, sh)
+
+			continue
 		}
+
+		fp.analyzeStruct(pass, sh)
 	}
 
-	if fp.features.IsEnabled(FunctionCheck) {
+	if fp.settings.Features.IsEnabled(FunctionCheck) || fp.settings.HelperPlacement == HelperPlacementBottom {
 		fp.analyzeFunctions(pass)
 	}
+
+	if fp.settings.Features.IsEnabled(AlphabeticalFunctions) {
+		fp.analyzeFunctionsAlphabetically(pass)
+	}
+
+	if fp.settings.HelperPlacement == HelperPlacementAfterCaller {
+		fp.analyzeHelperPlacement(pass)
+	}
+
+	if fp.settings.Features.IsEnabled(ExportedFirstCheck) {
+		fp.analyzeExportedFirst(pass)
+	}
+
+	if fp.settings.Features.IsEnabled(TypeOrderCheck) {
+		fp.analyzeTypeOrder(pass)
+	}
+
+	if fp.settings.Stepdown != "" && fp.settings.Stepdown != StepdownNone {
+		fp.analyzeStepdown(pass)
+	}
+
+	if len(fp.settings.FileLayout) > 0 {
+		fp.analyzeFileLayout(pass)
+	}
+}
+
+// UseTypes sets the type checker information used to detect the constructors.
+func (fp *FileProcessor) UseTypes(pkg *types.Package, info *types.Info) {
+	fp.pkg = pkg
+	fp.typesInfo = info
+}
+
+// UsePackageFiles collects the types declared in the files of the package, used by the cross-file policies.
+func (fp *FileProcessor) UsePackageFiles(files []*ast.File) {
+	if fp.settings.CrossFile == "" || fp.settings.CrossFile == CrossFileNone {
+		return
+	}
+
+	fp.packageTypes = make(map[string]*ast.TypeSpec)
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				if ts, isTypeSpec := spec.(*ast.TypeSpec); isTypeSpec {
+					fp.packageTypes[ts.Name.Name] = ts
+				}
+			}
+		}
+	}
 }
 
 func (fp *FileProcessor) ResetStructs() {
 	fp.structs = make(map[string]*StructHolder)
 	fp.topLevelFuncs = nil
+	fp.funcDecls = nil
+	fp.file = nil
+}
+
+// SetFile sets the file being processed, used by the checks that look at all its declarations.
+func (fp *FileProcessor) SetFile(file *ast.File) {
+	fp.file = file
 }
 
 func (fp *FileProcessor) AddFuncDecl(n *ast.FuncDecl) {
-	if fp.features.IsEnabled(FunctionCheck) && n.Recv == nil {
+	fp.funcDecls = append(fp.funcDecls, n)
+
+	if fp.checksTopLevelFuncs() && n.Recv == nil {
 		fp.topLevelFuncs = append(fp.topLevelFuncs, n)
 	}
 
-	if sc := NewStructConstructor(n); sc != nil {
+	if sc := fp.newStructConstructor(n); sc != nil {
 		sh := fp.getOrCreate(sc.StructReturn.Name)
 		sh.Constructors = append(sh.Constructors, sc.Constructor)
 
+		if isBareConstructor(n, fp.settings.ConstructorPatterns) {
+			sh.BareConstructors = append(sh.BareConstructors, n)
+		}
+
 		return
 	}
 
@@ -63,27 +151,218 @@ func (fp *FileProcessor) AddTypeSpec(n *ast.TypeSpec) {
 	sh.Struct = n
 }
 
+func (fp *FileProcessor) newStructConstructor(n *ast.FuncDecl) *StructConstructor {
+	if fp.settings.Features.IsEnabled(TypeAwareConstructors) {
+		return NewTypedStructConstructor(n, fp.pkg, fp.typesInfo)
+	}
+
+	return NewStructConstructor(n, fp.settings.ConstructorPatterns)
+}
+
+// analyzeStruct applies the checks of the struct, its constructors and its methods.
+func (fp *FileProcessor) analyzeStruct(pass *analysis.Pass, sh *StructHolder) {
+	sh.Analyze(pass)
+
+	if fp.settings.Features.IsEnabled(ContiguousMethodsCheck) {
+		fp.analyzeContiguousMethods(pass, sh)
+	}
+
+	if fp.settings.Features.IsEnabled(InterfaceMethodsCheck) {
+		fp.analyzeInterfaceMethods(pass, sh)
+	}
+
+	if !sh.StructInOtherFile && fp.settings.Features.IsEnabled(ConstructorCheck) &&
+		fp.settings.ConstructorPlacement == ConstructorAdjacent {
+		fp.analyzeAdjacentConstructors(pass, sh)
+	}
+}
+
+// analyzeCrossFile applies the cross-file policy to the constructors and methods of a type declared in another file.
+// The test files are excluded from the same-file policy, as they can't be merged into the file of the type.
+func (fp *FileProcessor) analyzeCrossFile(pass *analysis.Pass, structName string, sh *StructHolder) {
+	structSpec, found := fp.packageTypes[structName]
+	if !found {
+		return
+	}
+
+	switch fp.settings.CrossFile {
+	case CrossFileSameFile:
+		structFile := filepath.Base(pass.Fset.Position(structSpec.Pos()).Filename)
+
+		for _, fn := range slices.Concat(sh.Constructors, sh.StructMethods) {
+			if !strings.HasSuffix(pass.Fset.Position(fn.Pos()).Filename, "_test.go") {
+				reportNotInStructFile(pass, structSpec, fn, structFile)
+			}
+		}
+
+	case CrossFilePerFile:
+		// the holder is copied, so the other checks of the file don't see the type as declared inside it
+		perFile := *sh
+		perFile.Struct = structSpec
+		perFile.StructInOtherFile = true
+		fp.analyzeStruct(pass, &perFile)
+
+	case CrossFileNone:
+	}
+}
+
+// analyzeContiguousMethods reports every function placed between the first and the last method of the struct,
+// that is neither one of its methods nor one of its constructors.
+func (fp *FileProcessor) analyzeContiguousMethods(pass *analysis.Pass, sh *StructHolder) {
+	if len(sh.StructMethods) < 2 {
+		return
+	}
+
+	first, last := sh.StructMethods[0], sh.StructMethods[len(sh.StructMethods)-1]
+
+	for _, fn := range fp.funcDecls {
+		if fn.Pos() < first.Pos() || fn.Pos() > last.Pos() {
+			continue
+		}
+
+		if slices.Contains(sh.StructMethods, fn) || slices.Contains(sh.Constructors, fn) {
+			continue
+		}
+
+		reportFuncInsideMethodBlock(pass, sh.Struct, fn, first, last)
+	}
+}
+
+// analyzeAdjacentConstructors reports the constructors that are not placed directly after the struct declaration.
+// Only the other constructors of the struct, and the consts and vars that use the struct or its constructors,
+// can be placed in between.
+func (fp *FileProcessor) analyzeAdjacentConstructors(pass *analysis.Pass, sh *StructHolder) {
+	if fp.file == nil {
+		return
+	}
+
+	i := slices.IndexFunc(fp.file.Decls, func(decl ast.Decl) bool {
+		return decl.Pos() <= sh.Struct.Pos() && sh.Struct.End() <= decl.End()
+	})
+	if i < 0 {
+		return
+	}
+
+	structDecl, _ := fp.file.Decls[i].(*ast.GenDecl)
+	if structDecl == nil {
+		return
+	}
+
+	for _, c := range sh.Constructors {
+		if c.Pos() < structDecl.End() {
+			continue
+		}
+
+		for _, decl := range fp.file.Decls[i+1:] {
+			if decl.Pos() >= c.Pos() {
+				break
+			}
+
+			if !fp.isRelatedDecl(sh, decl) {
+				reportConstructorNotAdjacentToStruct(pass, sh.Struct, c, decl)
+
+				break
+			}
+		}
+	}
+}
+
+// isRelatedDecl checks whether the declaration is a constructor of the struct,
+// or a const or var declaration that uses the struct or one of its constructors.
+// The type checker information, if available, is used to ignore the names declared in other scopes.
+func (fp *FileProcessor) isRelatedDecl(sh *StructHolder, decl ast.Decl) bool {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		return slices.Contains(sh.Constructors, d)
+
+	case *ast.GenDecl:
+		if d.Tok != token.CONST && d.Tok != token.VAR {
+			return false
+		}
+
+		decls := map[string]*ast.Ident{sh.Struct.Name.Name: sh.Struct.Name}
+		for _, c := range sh.Constructors {
+			decls[c.Name.Name] = c.Name
+		}
+
+		related := false
+
+		var visit func(n ast.Node) bool
+		visit = func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.SelectorExpr:
+				// the selected name belongs to another package or type
+				ast.Inspect(node.X, visit)
+
+				return false
+
+			case *ast.Ident:
+				related = related || fp.usesDecl(node, decls[node.Name])
+			}
+
+			return !related
+		}
+
+		for _, spec := range d.Specs {
+			if vs, ok := spec.(*ast.ValueSpec); ok {
+				if vs.Type != nil {
+					ast.Inspect(vs.Type, visit)
+				}
+
+				for _, value := range vs.Values {
+					ast.Inspect(value, visit)
+				}
+			}
+		}
+
+		return related
+	}
+
+	return false
+}
+
+// usesDecl checks whether the identifier refers to the declared name,
+// only by its name if there is no type checker information.
+func (fp *FileProcessor) usesDecl(ident, decl *ast.Ident) bool {
+	if decl == nil {
+		return false
+	}
+
+	if fp.typesInfo == nil {
+		return true
+	}
+
+	obj := fp.typesInfo.Uses[ident]
+
+	return obj != nil && obj.Pos() == decl.Pos()
+}
+
 // analyzeFunctions reports every unexported top-level function that appears
-// before the last exported top-level function in source order.
-// The `init` function is excluded from this check.
+// before the last exported top-level function in source order, or the reverse if the unexported functions go first.
+// The `init` function is excluded from this check, and so are the constructors
+// of the structs declared in the file if the constructor check is enabled, as they are placed after their struct.
 func (fp *FileProcessor) analyzeFunctions(pass *analysis.Pass) {
-	var lastExported *ast.FuncDecl
+	unexportedFirst := fp.settings.VisibilityOrder == VisibilityUnexportedFirst
+
+	var lastFirst *ast.FuncDecl
+
+	constructors := fp.placedConstructors()
 
 	for _, fn := range fp.topLevelFuncs {
 		if fn.Name.Name == "init" {
 			continue
 		}
 
-		if !fn.Name.IsExported() {
+		if fn.Name.IsExported() == unexportedFirst {
 			continue
 		}
 
-		if lastExported == nil || fn.Pos() > lastExported.Pos() {
-			lastExported = fn
+		if lastFirst == nil || fn.Pos() > lastFirst.Pos() {
+			lastFirst = fn
 		}
 	}
 
-	if lastExported == nil {
+	if lastFirst == nil {
 		return
 	}
 
@@ -92,12 +371,127 @@ func (fp *FileProcessor) analyzeFunctions(pass *analysis.Pass) {
 			continue
 		}
 
-		if fn.Name.IsExported() || fn.Pos() >= lastExported.Pos() {
+		if fn.Name.IsExported() != unexportedFirst || fn.Pos() >= lastFirst.Pos() || constructors[fn] {
+			continue
+		}
+
+		if unexportedFirst {
+			reportExportedFuncBeforeUnexportedFunc(pass, fn, lastFirst)
+		} else {
+			reportUnexportedFuncBeforeExportedFunc(pass, fn, lastFirst)
+		}
+	}
+}
+
+// analyzeFunctionsAlphabetically reports the fewest top-level functions that have to be moved to sort them
+// alphabetically, within the exported and the unexported functions.
+// The `init` and `main` functions are excluded from this check, and so are the constructors
+// of the structs declared in the file, as they are sorted with the constructors scope.
+func (fp *FileProcessor) analyzeFunctionsAlphabetically(pass *analysis.Pass) {
+	constructors := fp.fileConstructors()
+
+	var funcs []*ast.FuncDecl
+
+	for _, fn := range fp.topLevelFuncs {
+		if fn.Name.Name == "init" || fn.Name.Name == "main" || constructors[fn] {
+			continue
+		}
+
+		funcs = append(funcs, fn)
+	}
+
+	exported, unexported := splitExportedUnexported(funcs)
+
+	compare := func(a, b *ast.FuncDecl) int {
+		return fp.settings.NameComparator.Compare(a.Name.Name, b.Name.Name)
+	}
+
+	for _, group := range [][]*ast.FuncDecl{exported, unexported} {
+		for _, m := range misplacements(group, compare) {
+			reportFuncNotSortedAlphabetically(pass, m.item, m.neighbour, m.after)
+		}
+	}
+}
+
+// checksTopLevelFuncs checks whether the top-level functions are needed by the enabled checks.
+func (fp *FileProcessor) checksTopLevelFuncs() bool {
+	return fp.settings.Features.IsEnabled(FunctionCheck) || fp.settings.Features.IsEnabled(AlphabeticalFunctions) ||
+		fp.settings.HelperPlacement == HelperPlacementBottom
+}
+
+// analyzeExportedFirst reports every unexported top-level declaration, of any kind,
+// that appears before the last exported top-level declaration in source order.
+// The imports, the `init` function, the blank identifiers and the methods are excluded from this check,
+// and so are the constructors of the structs declared in the file if the constructor check is enabled.
+func (fp *FileProcessor) analyzeExportedFirst(pass *analysis.Pass) {
+	if fp.file == nil {
+		return
+	}
+
+	constructors := fp.placedConstructors()
+
+	var (
+		checked      []ast.Decl
+		lastExported ast.Decl
+	)
+
+	for _, decl := range fp.file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && constructors[fn] {
+			continue
+		}
+
+		exported, ok := declExported(decl)
+		if !ok {
 			continue
 		}
 
-		reportUnexportedFuncBeforeExportedFunc(pass, fn, lastExported)
+		checked = append(checked, decl)
+
+		if exported {
+			lastExported = decl
+		}
 	}
+
+	if lastExported == nil {
+		return
+	}
+
+	for _, decl := range checked {
+		if decl.Pos() >= lastExported.Pos() {
+			break
+		}
+
+		if exported, _ := declExported(decl); !exported {
+			reportUnexportedDeclBeforeExportedDecl(pass, decl, lastExported)
+		}
+	}
+}
+
+// placedConstructors returns the constructors of the structs declared in the file if the constructor check is enabled,
+// as they are placed after their struct.
+func (fp *FileProcessor) placedConstructors() map[*ast.FuncDecl]bool {
+	if !fp.settings.Features.IsEnabled(ConstructorCheck) {
+		return make(map[*ast.FuncDecl]bool)
+	}
+
+	return fp.fileConstructors()
+}
+
+// fileConstructors returns the constructors of the structs declared in the file.
+func (fp *FileProcessor) fileConstructors() map[*ast.FuncDecl]bool {
+	constructors := make(map[*ast.FuncDecl]bool)
+
+	for _, sh := range fp.structs {
+		if sh.Struct == nil {
+			continue
+		}
+
+		for _, c := range sh.Constructors {
+			constructors[c] = true
+		}
+	}
+
+	return constructors
 }
 
 func (fp *FileProcessor) getOrCreate(structName string) *StructHolder {
@@ -106,13 +500,58 @@ func (fp *FileProcessor) getOrCreate(structName string) *StructHolder {
 	}
 
 	created := &StructHolder{
-		Features: fp.features,
+		Features:          fp.settings.Features,
+		ConstructorOrder:  fp.settings.ConstructorOrder,
+		VisibilityOrder:   fp.settings.VisibilityOrder,
+		ReceiverKindOrder: fp.settings.ReceiverKindOrder,
+		NameComparator:    fp.settings.NameComparator,
 	}
 	fp.structs[structName] = created
 
 	return created
 }
 
+// declExported returns whether the top-level declaration is exported, a declaration with several names is exported
+// if any of them is, and false as second value if it's not checked by the exported first check.
+//
+//nolint:nonamedreturns // names serve as documentation
+func declExported(decl ast.Decl) (exported, checked bool) {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Recv != nil || d.Name.Name == "init" {
+			return false, false
+		}
+
+		return d.Name.IsExported(), true
+
+	case *ast.GenDecl:
+		for _, spec := range d.Specs {
+			var names []*ast.Ident
+
+			switch sp := spec.(type) {
+			case *ast.TypeSpec:
+				names = []*ast.Ident{sp.Name}
+			case *ast.ValueSpec:
+				names = sp.Names
+			}
+
+			for _, name := range names {
+				if name.Name == "_" {
+					continue
+				}
+
+				checked = true
+				exported = exported || name.IsExported()
+			}
+		}
+
+		return exported, checked
+
+	default:
+		return false, false
+	}
+}
+
 func funcIsMethod(n *ast.FuncDecl) *ast.Ident {
 	if n.Recv == nil {
 		return nil
@@ -125,11 +564,32 @@ func funcIsMethod(n *ast.FuncDecl) *ast.Ident {
 	return getIdent(n.Recv.List[0].Type)
 }
 
+// hasPointerReceiver checks whether the method has a pointer receiver, e.g. `*T`, `*T[K]` or `(*T)`.
+func hasPointerReceiver(n *ast.FuncDecl) bool {
+	if n.Recv == nil || len(n.Recv.List) != 1 {
+		return false
+	}
+
+	_, ok := ast.Unparen(n.Recv.List[0].Type).(*ast.StarExpr)
+
+	return ok
+}
+
+// getIdent returns the name of the type, e.g. `T` for `*T`, `T[K]`, `*T[K, V]` or `(*T)`.
 func getIdent(expr ast.Expr) *ast.Ident {
 	switch exp := expr.(type) {
 	case *ast.StarExpr:
 		return getIdent(exp.X)
 
+	case *ast.ParenExpr:
+		return getIdent(exp.X)
+
+	case *ast.IndexExpr:
+		return getIdent(exp.X)
+
+	case *ast.IndexListExpr:
+		return getIdent(exp.X)
+
 	case *ast.Ident:
 		return exp
 
@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"fmt"
+	"go/ast"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// moveConstructorAfterStructFix returns the suggested fix that places the constructor just after the struct.
+func moveConstructorAfterStructFix(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	constructor *ast.FuncDecl,
+) []analysis.SuggestedFix {
+	sf := newSourceFile(pass, constructor.Pos())
+	if sf == nil {
+		return nil
+	}
+
+	structDecl := sf.enclosingDecl(structSpec)
+	if structDecl == nil {
+		return nil
+	}
+
+	return []analysis.SuggestedFix{{
+		Message:   fmt.Sprintf("Move constructor after struct %q", structSpec.Name),
+		TextEdits: sf.moveAfter(constructor, structDecl),
+	}}
+}
+
+// moveConstructorBeforeMethodFix returns the suggested fix that places the constructor just before the struct method.
+func moveConstructorBeforeMethodFix(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	constructor, method *ast.FuncDecl,
+) []analysis.SuggestedFix {
+	sf := newSourceFile(pass, constructor.Pos())
+	if sf == nil {
+		return nil
+	}
+
+	return []analysis.SuggestedFix{{
+		Message:   fmt.Sprintf("Move constructor before struct method %q of struct %q", method.Name, structSpec.Name),
+		TextEdits: sf.moveBefore(constructor, method),
+	}}
+}
+
+// moveMethodAfterStructFix returns the suggested fix that places the method after the struct declaration,
+// just before the first method declared after the struct, or otherwise after the constructors that follow the struct.
+func moveMethodAfterStructFix(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	method *ast.FuncDecl,
+	constructors, methods []*ast.FuncDecl,
+) []analysis.SuggestedFix {
+	sf := newSourceFile(pass, method.Pos())
+	if sf == nil {
+		return nil
+	}
+
+	fix := analysis.SuggestedFix{
+		Message: fmt.Sprintf("Move method after struct %q", structSpec.Name),
+	}
+
+	if i := slices.IndexFunc(methods, func(m *ast.FuncDecl) bool { return m.Pos() > structSpec.Pos() }); i >= 0 {
+		fix.TextEdits = sf.moveBefore(method, methods[i])
+
+		return []analysis.SuggestedFix{fix}
+	}
+
+	after := sf.enclosingDecl(structSpec)
+	if after == nil {
+		return nil
+	}
+
+	for _, c := range constructors {
+		if c.Pos() > after.Pos() {
+			after = c
+		}
+	}
+
+	fix.TextEdits = sf.moveAfter(method, after)
+
+	return []analysis.SuggestedFix{fix}
+}
+
+// sortStructMethodsFix returns the suggested fix that rewrites the struct methods in the sorted order.
+// Each method is written, with its comments, in the place of the method it replaces,
+// so the rest of the file is left untouched.
+func sortStructMethodsFix(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	methods, sorted []*ast.FuncDecl,
+) []analysis.SuggestedFix {
+	sf := newSourceFile(pass, methods[0].Pos())
+	if sf == nil {
+		return nil
+	}
+
+	return []analysis.SuggestedFix{{
+		Message:   fmt.Sprintf("Sort methods of struct %q", structSpec.Name),
+		TextEdits: reorder(sf, methods, sorted),
+	}}
+}
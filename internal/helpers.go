@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// analyzeHelperPlacement reports the unexported functions and methods with only one caller in the file
+// that are not placed right after it, or after its other helpers.
+// The helpers of a helper are also considered helpers of its caller, e.g. `Format`, `trim`, `trimLeft` and `upper`.
+func (fp *FileProcessor) analyzeHelperPlacement(pass *analysis.Pass) {
+	if fp.file == nil {
+		return
+	}
+
+	g := newCallGraph(fp.funcDecls, fp.typesInfo)
+	constructors := fp.placedConstructors()
+
+	onlyCaller := make(map[*ast.FuncDecl]*ast.FuncDecl)
+
+	for _, fn := range g.funcs {
+		if fn.Name.IsExported() || fn.Name.Name == "init" || constructors[fn] {
+			continue
+		}
+
+		if callers := g.callers(fn); len(callers) == 1 {
+			onlyCaller[fn] = callers[0]
+		}
+	}
+
+	for fn, caller := range onlyCaller {
+		if !isPlacedAfterCaller(fp.file.Decls, onlyCaller, fn, caller) {
+			reportHelperNotAfterCaller(pass, fn, caller)
+		}
+	}
+}
+
+// isPlacedAfterCaller checks whether the declarations between the caller and the helper are helpers of the caller.
+func isPlacedAfterCaller(
+	decls []ast.Decl,
+	onlyCaller map[*ast.FuncDecl]*ast.FuncDecl,
+	helper, caller *ast.FuncDecl,
+) bool {
+	if helper.Pos() < caller.Pos() {
+		return false
+	}
+
+	for _, decl := range decls {
+		if decl.Pos() <= caller.Pos() {
+			continue
+		}
+
+		if decl == ast.Decl(helper) {
+			return true
+		}
+
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !isHelperOf(onlyCaller, fn, caller) {
+			return false
+		}
+	}
+
+	return false
+}
+
+// isHelperOf checks whether the function is a helper of the caller, or a helper of one of its helpers.
+func isHelperOf(onlyCaller map[*ast.FuncDecl]*ast.FuncDecl, fn, caller *ast.FuncDecl) bool {
+	seen := make(map[*ast.FuncDecl]bool)
+
+	for parent := onlyCaller[fn]; parent != nil && !seen[parent]; parent = onlyCaller[parent] {
+		if parent == caller {
+			return true
+		}
+
+		seen[parent] = true
+	}
+
+	return false
+}
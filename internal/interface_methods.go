@@ -0,0 +1,392 @@
+package internal
+
+import (
+	"cmp"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// orderedInterface is an interface with the names of its methods in the order they are declared.
+type orderedInterface struct {
+	name    string
+	iface   *types.Interface
+	methods []string
+}
+
+// analyzeInterfaceMethods checks that the methods of the struct implementing an interface are contiguous,
+// and that they follow the order of the interface declaration.
+func (fp *FileProcessor) analyzeInterfaceMethods(pass *analysis.Pass, sh *StructHolder) {
+	for _, iface := range fp.implementedInterfaces(sh.Struct.Name.Name) {
+		var methods []*ast.FuncDecl
+
+		index := make(map[*ast.FuncDecl]int)
+
+		for _, m := range sh.StructMethods {
+			if i := slices.Index(iface.methods, m.Name.Name); i >= 0 {
+				methods = append(methods, m)
+				index[m] = i
+			}
+		}
+
+		if len(methods) < 2 {
+			continue
+		}
+
+		first, last := methods[0], methods[len(methods)-1]
+
+		for _, m := range sh.StructMethods {
+			if _, ok := index[m]; !ok && m.Pos() > first.Pos() && m.Pos() < last.Pos() {
+				reportMethodInsideInterfaceMethods(pass, sh.Struct, m, iface.name)
+			}
+		}
+
+		inInterfaceOrder := func(a, b *ast.FuncDecl) int {
+			return cmp.Compare(index[a], index[b])
+		}
+
+		for _, m := range misplacements(methods, inInterfaceOrder) {
+			reportMethodNotInInterfaceOrder(pass, sh.Struct, m.item, m.neighbour, m.after, iface.name)
+		}
+	}
+}
+
+// implementedInterfaces returns the interfaces implemented by the struct, or by a pointer to it.
+// Generic structs are not checked.
+func (fp *FileProcessor) implementedInterfaces(structName string) []orderedInterface {
+	if fp.pkg == nil || len(fp.interfaces) == 0 {
+		return nil
+	}
+
+	obj, ok := fp.pkg.Scope().Lookup(structName).(*types.TypeName)
+	if !ok {
+		return nil
+	}
+
+	named, ok := obj.Type().(*types.Named)
+	if !ok || named.TypeParams().Len() > 0 {
+		return nil
+	}
+
+	var implemented []orderedInterface
+
+	for _, iface := range fp.interfaces {
+		if types.Implements(named, iface.iface) || types.Implements(types.NewPointer(named), iface.iface) {
+			implemented = append(implemented, iface)
+		}
+	}
+
+	return implemented
+}
+
+// InterfaceMethodsFact is the order of the methods of an interface declared in a package,
+// exported to check the implementations of the interface in the packages importing it.
+type InterfaceMethodsFact struct {
+	Methods []string
+}
+
+func (*InterfaceMethodsFact) AFact() {}
+
+func (f *InterfaceMethodsFact) String() string {
+	return "interfaceMethods(" + strings.Join(f.Methods, ", ") + ")"
+}
+
+// InterfaceOrders are the names of the methods of the interfaces in the order they are declared,
+// the ones declared in the package and the ones imported from the facts of its dependencies.
+type InterfaceOrders map[*types.TypeName][]string
+
+// ExportInterfaceFacts exports the order of the methods of the interfaces declared in the package as facts,
+// so the packages importing them check their implementations against it,
+// and returns it with the order of the interfaces of the dependencies.
+func ExportInterfaceFacts(pass *analysis.Pass) InterfaceOrders {
+	declared := make(map[*types.TypeName]*ast.InterfaceType)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				ts, isTypeSpec := spec.(*ast.TypeSpec)
+				if !isTypeSpec {
+					continue
+				}
+
+				obj, isTypeName := pass.TypesInfo.Defs[ts.Name].(*types.TypeName)
+				if it, isInterface := ts.Type.(*ast.InterfaceType); isTypeName && isInterface {
+					declared[obj] = it
+				}
+			}
+		}
+	}
+
+	orders := &interfaceOrders{
+		pass:     pass,
+		declared: declared,
+		methods:  make(map[*types.TypeName][]string),
+	}
+
+	scope := pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		if obj, ok := scope.Lookup(name).(*types.TypeName); ok && declared[obj] != nil {
+			pass.ExportObjectFact(obj, &InterfaceMethodsFact{Methods: orders.of(obj)})
+		}
+	}
+
+	all := make(InterfaceOrders)
+
+	for _, f := range pass.AllObjectFacts() {
+		obj, isTypeName := f.Object.(*types.TypeName)
+		if fact, isInterfaceFact := f.Fact.(*InterfaceMethodsFact); isTypeName && isInterfaceFact {
+			all[obj] = fact.Methods
+		}
+	}
+
+	return all
+}
+
+// of returns the names of the methods of the interface in the order they are declared,
+// or sorted by position if the order is unknown.
+func (o InterfaceOrders) of(obj *types.TypeName) []string {
+	if methods, ok := o[obj]; ok {
+		return methods
+	}
+
+	if iface, ok := obj.Type().Underlying().(*types.Interface); ok {
+		return appendMissingMethods(nil, iface)
+	}
+
+	return nil
+}
+
+// UseInterfaces collects the interfaces declared in the package, the ones declared in the packages it imports,
+// with their fact, and the configured ones, looked up in the package and in all its dependencies.
+// The configured interfaces that are not found are reported.
+func (fp *FileProcessor) UseInterfaces(pass *analysis.Pass, orders InterfaceOrders) {
+	fp.interfaces = nil
+
+	if pass.Pkg == nil || orders == nil || !fp.settings.Features.IsEnabled(InterfaceMethodsCheck) {
+		return
+	}
+
+	added := make(map[*types.TypeName]bool)
+	add := func(name string, obj *types.TypeName) {
+		if added[obj] {
+			return
+		}
+
+		added[obj] = true
+
+		if iface := newOrderedInterface(name, obj, orders.of(obj)); iface != nil {
+			fp.interfaces = append(fp.interfaces, *iface)
+		}
+	}
+
+	scope := pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		if obj, ok := scope.Lookup(name).(*types.TypeName); ok && orders[obj] != nil {
+			add(name, obj)
+		}
+	}
+
+	for _, pkg := range pass.Pkg.Imports() {
+		for _, name := range pkg.Scope().Names() {
+			obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+			if ok && obj.Exported() && orders[obj] != nil {
+				add(pkg.Path()+"."+name, obj)
+			}
+		}
+	}
+
+	for _, qualified := range fp.settings.Interfaces {
+		obj := lookupInterface(pass.Pkg, orders, qualified)
+		if obj == nil {
+			if len(pass.Files) > 0 {
+				reportInterfaceNotFound(pass, pass.Files[0], qualified)
+			}
+
+			continue
+		}
+
+		add(qualified, obj)
+	}
+}
+
+// lookupInterface returns the interface with the qualified name, looked up in the package and in all its dependencies,
+// as a type can implement an interface without importing its package, or nil if it's not found.
+func lookupInterface(pkg *types.Package, orders InterfaceOrders, qualified string) *types.TypeName {
+	i := strings.LastIndex(qualified, ".")
+	if i < 0 {
+		return nil
+	}
+
+	path, name := qualified[:i], qualified[i+1:]
+
+	isInterface := func(obj *types.TypeName) bool {
+		_, ok := obj.Type().Underlying().(*types.Interface)
+
+		return ok
+	}
+
+	visited := make(map[*types.Package]bool)
+	queue := []*types.Package{pkg}
+
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+
+		if visited[p] {
+			continue
+		}
+
+		visited[p] = true
+
+		if p.Path() == path {
+			if obj, ok := p.Scope().Lookup(name).(*types.TypeName); ok && isInterface(obj) {
+				return obj
+			}
+
+			return nil
+		}
+
+		queue = append(queue, p.Imports()...)
+	}
+
+	// the imports of the dependencies can be incomplete, e.g. if they are loaded from the export data,
+	// the interfaces with a fact are looked up too
+	for obj := range orders {
+		if obj.Pkg() != nil && obj.Pkg().Path() == path && obj.Name() == name && isInterface(obj) {
+			return obj
+		}
+	}
+
+	return nil
+}
+
+// newOrderedInterface returns the interface declared by the type name, with the methods in the given order,
+// or nil if it's not an interface with at least two methods.
+// Generic interfaces and type constraints are not checked.
+func newOrderedInterface(name string, obj *types.TypeName, methods []string) *orderedInterface {
+	if named, isNamed := obj.Type().(*types.Named); isNamed && named.TypeParams().Len() > 0 {
+		return nil
+	}
+
+	iface, ok := obj.Type().Underlying().(*types.Interface)
+	if !ok || !iface.IsMethodSet() || iface.NumMethods() < 2 {
+		return nil
+	}
+
+	return &orderedInterface{
+		name:    name,
+		iface:   iface,
+		methods: methods,
+	}
+}
+
+// interfaceOrders computes the order of the methods of the interfaces declared in the package.
+type interfaceOrders struct {
+	pass *analysis.Pass
+
+	// the interfaces declared in the package
+	declared map[*types.TypeName]*ast.InterfaceType
+
+	// the order of the methods of the interfaces already computed
+	methods map[*types.TypeName][]string
+}
+
+// of returns the names of the methods of the interface in the order they are declared,
+// the methods of an embedded interface are placed where it's embedded.
+// The order of the interfaces declared in other packages is imported from their fact,
+// or the position of their methods is used if there is no fact.
+func (o *interfaceOrders) of(obj *types.TypeName) []string {
+	if methods, ok := o.methods[obj]; ok {
+		return methods
+	}
+
+	// placeholder to stop on invalid recursive embeddings
+	o.methods[obj] = nil
+
+	var methods []string
+
+	fact := new(InterfaceMethodsFact)
+
+	switch it := o.declared[obj]; {
+	case it != nil:
+		methods = o.declaredOrder(it)
+	case o.pass.ImportObjectFact(obj, fact):
+		methods = fact.Methods
+	}
+
+	if iface, ok := obj.Type().Underlying().(*types.Interface); ok {
+		methods = appendMissingMethods(methods, iface)
+	}
+
+	o.methods[obj] = methods
+
+	return methods
+}
+
+// declaredOrder returns the names of the methods of an interface declared in the package,
+// in the order they are declared.
+func (o *interfaceOrders) declaredOrder(it *ast.InterfaceType) []string {
+	var methods []string
+
+	for _, field := range it.Methods.List {
+		if len(field.Names) > 0 {
+			for _, name := range field.Names {
+				if !slices.Contains(methods, name.Name) {
+					methods = append(methods, name.Name)
+				}
+			}
+
+			continue
+		}
+
+		embedded := o.pass.TypesInfo.TypeOf(field.Type)
+		if embedded == nil {
+			continue
+		}
+
+		var embeddedMethods []string
+		if named, ok := embedded.(*types.Named); ok {
+			embeddedMethods = o.of(named.Obj())
+		} else if iface, isInterface := embedded.Underlying().(*types.Interface); isInterface {
+			embeddedMethods = appendMissingMethods(nil, iface)
+		}
+
+		for _, name := range embeddedMethods {
+			if !slices.Contains(methods, name) {
+				methods = append(methods, name)
+			}
+		}
+	}
+
+	return methods
+}
+
+// appendMissingMethods appends the methods of the interface that are not in the list, sorted by position.
+func appendMissingMethods(methods []string, iface *types.Interface) []string {
+	var missing []*types.Func
+
+	for i := range iface.NumMethods() {
+		if m := iface.Method(i); !slices.Contains(methods, m.Name()) {
+			missing = append(missing, m)
+		}
+	}
+
+	slices.SortStableFunc(missing, func(a, b *types.Func) int {
+		return cmp.Compare(a.Pos(), b.Pos())
+	})
+
+	for _, m := range missing {
+		methods = append(methods, m.Name())
+	}
+
+	return methods
+}
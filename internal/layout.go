@@ -0,0 +1,230 @@
+package internal
+
+import (
+	"errors"
+	"go/ast"
+	"go/token"
+	"slices"
+)
+
+// RewriteFile returns the content of the file with its declarations placed in the order expected by the settings.
+// Each type declaration is followed by its constructors and then by its methods,
+// declarations are moved with their comments and everything else in the file is left untouched.
+func RewriteFile(settings Settings, fset *token.FileSet, file *ast.File, content []byte) ([]byte, error) {
+	tokFile := fset.File(file.Pos())
+	if tokFile == nil || tokFile.Size() != len(content) {
+		return nil, errors.New("the content does not match the parsed file")
+	}
+
+	fp := NewFileProcessor(settings)
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			fp.AddFuncDecl(d)
+
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					fp.AddTypeSpec(ts)
+				}
+			}
+		}
+	}
+
+	sf := &sourceFile{
+		file:    file,
+		tokFile: tokFile,
+		content: content,
+	}
+
+	return sf.apply(reorder(sf, file.Decls, fp.layout(file.Decls))), nil
+}
+
+// layout returns the declarations in the order expected by the enabled features.
+func (fp *FileProcessor) layout(decls []ast.Decl) []ast.Decl {
+	attached := make(map[ast.Decl]bool)
+
+	for _, sh := range fp.structs {
+		if sh.Struct == nil {
+			continue
+		}
+
+		for _, fn := range sh.layout() {
+			attached[fn] = true
+		}
+	}
+
+	out := make([]ast.Decl, 0, len(decls))
+
+	for _, decl := range decls {
+		if attached[decl] {
+			continue
+		}
+
+		out = append(out, decl)
+
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			ts, isTypeSpec := spec.(*ast.TypeSpec)
+			if !isTypeSpec {
+				continue
+			}
+
+			if sh, found := fp.structs[ts.Name.Name]; found && sh.Struct == ts {
+				for _, fn := range sh.layout() {
+					out = append(out, fn)
+				}
+			}
+		}
+	}
+
+	if fp.settings.Features.IsEnabled(FunctionCheck) || fp.settings.HelperPlacement == HelperPlacementBottom {
+		out = layoutFunctions(out, attached, fp.settings.VisibilityOrder == VisibilityUnexportedFirst)
+	}
+
+	if fp.settings.Features.IsEnabled(ExportedFirstCheck) {
+		out = layoutExportedFirst(out, attached, declExported)
+	}
+
+	if fp.settings.Features.IsEnabled(AlphabeticalFunctions) {
+		out = layoutFunctionsAlphabetically(out, attached, fp.fileConstructors(),
+			fp.settings.NameComparator)
+	}
+
+	return out
+}
+
+// layout returns the constructors and methods that follow the struct declaration, in the expected order.
+func (sh *StructHolder) layout() []*ast.FuncDecl {
+	var out []*ast.FuncDecl
+
+	if sh.Features.IsEnabled(ConstructorCheck) {
+		out = sh.sortedConstructors()
+	}
+
+	switch {
+	case sh.Features.IsEnabled(StructMethodCheck):
+		return append(out, sh.sortedStructMethods()...)
+
+	case sh.Features.IsEnabled(ConstructorCheck), sh.Features.IsEnabled(MethodAfterStructCheck),
+		sh.Features.IsEnabled(ContiguousMethodsCheck):
+		return append(out, sh.StructMethods...)
+
+	default:
+		return nil
+	}
+}
+
+// layoutFunctions moves the unexported functions placed before the last exported function just after it,
+// skipping the constructors and methods that follow it, or the reverse if the unexported functions go first.
+func layoutFunctions(decls []ast.Decl, attached map[ast.Decl]bool, unexportedFirst bool) []ast.Decl {
+	return layoutExportedFirst(decls, attached, func(decl ast.Decl) (bool, bool) {
+		if fn := topLevelFunc(decl); fn != nil {
+			return fn.Name.IsExported() != unexportedFirst, true
+		}
+
+		return false, false
+	})
+}
+
+// layoutExportedFirst moves the unexported declarations placed before the last exported declaration just after it,
+// skipping the constructors and methods that follow it.
+// exportedOf returns whether the declaration is exported, and false as second value if it's not moved.
+func layoutExportedFirst(
+	decls []ast.Decl,
+	attached map[ast.Decl]bool,
+	exportedOf func(ast.Decl) (bool, bool),
+) []ast.Decl {
+	last := -1
+
+	for i, decl := range decls {
+		if exported, ok := exportedOf(decl); ok && exported && !attached[decl] {
+			last = i
+		}
+	}
+
+	if last < 0 {
+		return decls
+	}
+
+	insertAt := last + 1
+	for insertAt < len(decls) && attached[decls[insertAt]] {
+		insertAt++
+	}
+
+	var (
+		kept, moved []ast.Decl
+		moving      bool
+	)
+
+	// the constructors and methods are moved together with the type declaration they follow
+	for _, decl := range decls[:insertAt] {
+		if !attached[decl] {
+			exported, ok := exportedOf(decl)
+			moving = ok && !exported
+		}
+
+		if moving {
+			moved = append(moved, decl)
+		} else {
+			kept = append(kept, decl)
+		}
+	}
+
+	return slices.Concat(kept, moved, decls[insertAt:])
+}
+
+// layoutFunctionsAlphabetically sorts the exported, and the unexported, top-level functions alphabetically,
+// each function is placed in the position of a function of the same group.
+// The `main` function, the constructors, and the methods that follow their type, are not moved.
+func layoutFunctionsAlphabetically(
+	decls []ast.Decl,
+	attached map[ast.Decl]bool,
+	constructors map[*ast.FuncDecl]bool,
+	comparator NameComparator,
+) []ast.Decl {
+	out := slices.Clone(decls)
+
+	for _, exported := range []bool{true, false} {
+		var (
+			indexes []int
+			funcs   []*ast.FuncDecl
+		)
+
+		for i, decl := range decls {
+			fn := topLevelFunc(decl)
+			if fn == nil || fn.Name.Name == "main" || attached[decl] || constructors[fn] ||
+				fn.Name.IsExported() != exported {
+				continue
+			}
+
+			indexes = append(indexes, i)
+			funcs = append(funcs, fn)
+		}
+
+		slices.SortStableFunc(funcs, func(a, b *ast.FuncDecl) int {
+			return comparator.Compare(a.Name.Name, b.Name.Name)
+		})
+
+		for i, index := range indexes {
+			out[index] = funcs[i]
+		}
+	}
+
+	return out
+}
+
+// topLevelFunc returns the function declaration if it's a function checked by the function check.
+func topLevelFunc(decl ast.Decl) *ast.FuncDecl {
+	fn, ok := decl.(*ast.FuncDecl)
+	if !ok || fn.Recv != nil || fn.Name.Name == "init" {
+		return nil
+	}
+
+	return fn
+}
@@ -0,0 +1,73 @@
+package internal
+
+// misplacement is a declaration that has to be moved to sort the declarations,
+// it's placed after the neighbour, or before it if it goes first.
+type misplacement[T any] struct {
+	item      T
+	neighbour T
+	after     bool
+}
+
+// misplacements returns the fewest declarations that have to be moved to sort the declarations.
+// The longest subsequence of declarations already sorted is kept, and each of the other declarations
+// is placed after the greatest kept declaration that goes before it.
+func misplacements[T any](items []T, compare func(a, b T) int) []misplacement[T] {
+	if len(items) < 2 {
+		return nil
+	}
+
+	// length[i] is the length of the longest sorted subsequence that ends with items[i]
+	length := make([]int, len(items))
+	prev := make([]int, len(items))
+	last := 0
+
+	for i := range items {
+		length[i], prev[i] = 1, -1
+
+		for j := range i {
+			if compare(items[j], items[i]) <= 0 && length[j]+1 > length[i] {
+				length[i], prev[i] = length[j]+1, j
+			}
+		}
+
+		if length[i] > length[last] {
+			last = i
+		}
+	}
+
+	kept := make([]bool, len(items))
+	for i := last; i >= 0; i = prev[i] {
+		kept[i] = true
+	}
+
+	var (
+		keptItems []T
+		out       []misplacement[T]
+	)
+
+	for i, item := range items {
+		if kept[i] {
+			keptItems = append(keptItems, item)
+		}
+	}
+
+	for i, item := range items {
+		if kept[i] {
+			continue
+		}
+
+		m := misplacement[T]{item: item, neighbour: keptItems[0]}
+
+		for _, k := range keptItems {
+			if compare(k, item) > 0 {
+				break
+			}
+
+			m.neighbour, m.after = k, true
+		}
+
+		out = append(out, m)
+	}
+
+	return out
+}
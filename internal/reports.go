@@ -7,12 +7,18 @@ import (
 	"golang.org/x/tools/go/analysis"
 )
 
-func reportConstructorNotAfterStructType(pass *analysis.Pass, structSpec *ast.TypeSpec, constructor *ast.FuncDecl) {
+func reportConstructorNotAfterStructType(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	constructor *ast.FuncDecl,
+	fixes []analysis.SuggestedFix,
+) {
 	pass.Report(analysis.Diagnostic{
 		Pos: constructor.Pos(),
 		Message: fmt.Sprintf("constructor %q for struct %q should be placed after the struct declaration",
 			constructor.Name, structSpec.Name),
-		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-constructors-functions-are-placed-after-struct-declaration", //nolint:lll // url
+		URL:            "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-constructors-functions-are-placed-after-struct-declaration", //nolint:lll // url
+		SuggestedFixes: fixes,
 	})
 }
 
@@ -20,25 +26,109 @@ func reportConstructorNotBeforeStructMethod(
 	pass *analysis.Pass,
 	structSpec *ast.TypeSpec,
 	constructor, method *ast.FuncDecl,
+	fixes []analysis.SuggestedFix,
 ) {
 	pass.Report(analysis.Diagnostic{
 		Pos: constructor.Pos(),
 		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-constructors-functions-are-placed-after-struct-declaration", //nolint:lll // url
 		Message: fmt.Sprintf("constructor %q for struct %q should be placed before struct method %q",
 			constructor.Name, structSpec.Name, method.Name),
+		SuggestedFixes: fixes,
+	})
+}
+
+func reportConstructorNotAdjacentToStruct(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	constructor *ast.FuncDecl,
+	decl ast.Decl,
+) {
+	pass.Report(analysis.Diagnostic{
+		Pos: constructor.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-constructors-functions-are-placed-after-struct-declaration", //nolint:lll // url
+		Message: fmt.Sprintf("constructor %q for struct %q should be placed directly after the struct declaration, "+
+			"found %s in between", constructor.Name, structSpec.Name, describeDecl(decl)),
 	})
 }
 
-func reportAdjacentConstructorsNotSortedAlphabetically(
+func reportConstructorNotSortedAlphabetically(
 	pass *analysis.Pass,
 	structSpec *ast.TypeSpec,
-	constructorNotSorted, otherConstructorNotSorted *ast.FuncDecl,
+	constructor, neighbour *ast.FuncDecl,
+	after bool,
 ) {
 	pass.Report(analysis.Diagnostic{
-		Pos: otherConstructorNotSorted.Pos(),
+		Pos: constructor.Pos(),
 		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-constructorsmethods-are-sorted-alphabetically",
+		Message: fmt.Sprintf("constructor %q for struct %q should be placed %s constructor %q",
+			constructor.Name, structSpec.Name, placement(after), neighbour.Name),
+	})
+}
+
+func reportBareConstructorNotFirst(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	bareConstructor, otherConstructor *ast.FuncDecl,
+) {
+	pass.Report(analysis.Diagnostic{
+		Pos: bareConstructor.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-constructors-functions-are-placed-after-struct-declaration", //nolint:lll // url
+		Message: fmt.Sprintf("constructor %q for struct %q should be placed before constructor %q",
+			bareConstructor.Name, structSpec.Name, otherConstructor.Name),
+	})
+}
+
+func reportConstructorGroupNotSorted(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	constructor, otherConstructor *ast.FuncDecl,
+) {
+	pass.Report(analysis.Diagnostic{
+		Pos: otherConstructor.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-constructors-functions-are-placed-after-struct-declaration", //nolint:lll // url
 		Message: fmt.Sprintf("constructor %q for struct %q should be placed before constructor %q",
-			otherConstructorNotSorted.Name, structSpec.Name, constructorNotSorted.Name),
+			otherConstructor.Name, structSpec.Name, constructor.Name),
+	})
+}
+
+func reportWrapperConstructorNotAfterWrapped(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	wrapper, wrapped *ast.FuncDecl,
+) {
+	pass.Report(analysis.Diagnostic{
+		Pos: wrapper.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-constructors-functions-are-placed-after-struct-declaration", //nolint:lll // url
+		Message: fmt.Sprintf("constructor %q for struct %q should be placed directly after constructor %q",
+			wrapper.Name, structSpec.Name, wrapped.Name),
+	})
+}
+
+func reportUnexportedConstructorBeforeExportedForStruct(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	privateConstructor, publicConstructor *ast.FuncDecl,
+) {
+	pass.Report(analysis.Diagnostic{
+		Pos: privateConstructor.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-constructors-functions-are-placed-after-struct-declaration", //nolint:lll // url
+		Message: fmt.Sprintf("unexported constructor %q for struct %q should be placed after the exported constructor %q",
+			privateConstructor.Name, structSpec.Name, publicConstructor.Name),
+	})
+}
+
+func reportMethodNotAfterStructType(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	method *ast.FuncDecl,
+	fixes []analysis.SuggestedFix,
+) {
+	pass.Report(analysis.Diagnostic{
+		Pos: method.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-methods-are-placed-after-struct-declaration",
+		Message: fmt.Sprintf("method %q for struct %q should be placed after the struct declaration",
+			method.Name, structSpec.Name),
+		SuggestedFixes: fixes,
 	})
 }
 
@@ -46,25 +136,134 @@ func reportUnexportedMethodBeforeExportedForStruct(
 	pass *analysis.Pass,
 	structSpec *ast.TypeSpec,
 	privateMethod, publicMethod *ast.FuncDecl,
+	fixes []analysis.SuggestedFix,
 ) {
 	pass.Report(analysis.Diagnostic{
 		Pos: privateMethod.Pos(),
 		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-exported-methods-are-placed-before-unexported-methods", //nolint:lll // url
 		Message: fmt.Sprintf("unexported method %q for struct %q should be placed after the exported method %q",
 			privateMethod.Name, structSpec.Name, publicMethod.Name),
+		SuggestedFixes: fixes,
+	})
+}
+
+func reportExportedMethodBeforeUnexportedForStruct(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	publicMethod, privateMethod *ast.FuncDecl,
+	fixes []analysis.SuggestedFix,
+) {
+	pass.Report(analysis.Diagnostic{
+		Pos: publicMethod.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-exported-methods-are-placed-before-unexported-methods", //nolint:lll // url
+		Message: fmt.Sprintf("exported method %q for struct %q should be placed after the unexported method %q",
+			publicMethod.Name, structSpec.Name, privateMethod.Name),
+		SuggestedFixes: fixes,
+	})
+}
+
+func reportReceiverKindNotSorted(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	method, lastFirstMethod *ast.FuncDecl,
+	fixes []analysis.SuggestedFix,
+) {
+	pass.Report(analysis.Diagnostic{
+		Pos: method.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-the-receiver-kind-of-the-methods",
+		Message: fmt.Sprintf("%s method %q for struct %q should be placed after the %s method %q",
+			receiverKind(method), method.Name, structSpec.Name, receiverKind(lastFirstMethod), lastFirstMethod.Name),
+		SuggestedFixes: fixes,
+	})
+}
+
+func reportMethodInsideInterfaceMethods(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	method *ast.FuncDecl,
+	interfaceName string,
+) {
+	pass.Report(analysis.Diagnostic{
+		Pos: method.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-the-methods-implementing-an-interface",
+		Message: fmt.Sprintf("method %q for struct %q should not be placed between the methods of the interface %q",
+			method.Name, structSpec.Name, interfaceName),
+	})
+}
+
+func reportInterfaceNotFound(pass *analysis.Pass, file *ast.File, interfaceName string) {
+	pass.Report(analysis.Diagnostic{
+		Pos: file.Package,
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-the-methods-implementing-an-interface",
+		Message: fmt.Sprintf("interface %q of the interfaces setting not found in the package or its dependencies",
+			interfaceName),
+	})
+}
+
+func reportMethodNotInInterfaceOrder(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	method, neighbour *ast.FuncDecl,
+	after bool,
+	interfaceName string,
+) {
+	pass.Report(analysis.Diagnostic{
+		Pos: method.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-the-methods-implementing-an-interface",
+		Message: fmt.Sprintf("method %q for struct %q should be placed %s method %q, following the interface %q",
+			method.Name, structSpec.Name, placement(after), neighbour.Name, interfaceName),
 	})
 }
 
-func reportAdjacentStructMethodsNotSortedAlphabetically(
+func reportStructMethodNotSortedAlphabetically(
 	pass *analysis.Pass,
 	structSpec *ast.TypeSpec,
-	method, otherMethod *ast.FuncDecl,
+	method, neighbour *ast.FuncDecl,
+	after bool,
+	fixes []analysis.SuggestedFix,
 ) {
 	pass.Report(analysis.Diagnostic{
-		Pos: otherMethod.Pos(),
+		Pos: method.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-constructorsmethods-are-sorted-alphabetically",
+		Message: fmt.Sprintf("method %q for struct %q should be placed %s method %q",
+			method.Name, structSpec.Name, placement(after), neighbour.Name),
+		SuggestedFixes: fixes,
+	})
+}
+
+func reportExportedFuncBeforeUnexportedFunc(pass *analysis.Pass, exportedFunc, unexportedFunc *ast.FuncDecl) {
+	pass.Report(analysis.Diagnostic{
+		Pos: exportedFunc.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-exported-functions-are-placed-before-unexported-functions", //nolint:lll // url
+		Message: fmt.Sprintf("exported function %q should be placed after the unexported function %q",
+			exportedFunc.Name, unexportedFunc.Name),
+	})
+}
+
+func reportFuncNotSortedAlphabetically(pass *analysis.Pass, fn, neighbour *ast.FuncDecl, after bool) {
+	pass.Report(analysis.Diagnostic{
+		Pos: fn.Pos(),
 		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-constructorsmethods-are-sorted-alphabetically",
-		Message: fmt.Sprintf("method %q for struct %q should be placed before method %q",
-			otherMethod.Name, structSpec.Name, method.Name),
+		Message: fmt.Sprintf("function %q should be placed %s function %q",
+			fn.Name, placement(after), neighbour.Name),
+	})
+}
+
+func reportFuncInsideMethodBlock(
+	pass *analysis.Pass,
+	structSpec *ast.TypeSpec,
+	fn, firstMethod, lastMethod *ast.FuncDecl,
+) {
+	kind := "function"
+	if fn.Recv != nil {
+		kind = "method"
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos: fn.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-the-methods-of-a-struct-are-contiguous",
+		Message: fmt.Sprintf("%s %q should not be placed inside the methods block of struct %q, between methods %q and %q",
+			kind, fn.Name, structSpec.Name, firstMethod.Name, lastMethod.Name),
 	})
 }
 
@@ -76,3 +275,141 @@ func reportUnexportedFuncBeforeExportedFunc(pass *analysis.Pass, unexportedFunc,
 			unexportedFunc.Name, exportedFunc.Name),
 	})
 }
+
+func reportNotInStructFile(pass *analysis.Pass, structSpec *ast.TypeSpec, fn *ast.FuncDecl, structFile string) {
+	kind := "constructor"
+	if fn.Recv != nil {
+		kind = "method"
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos: fn.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-constructorsmethods-are-placed-in-the-struct-file", //nolint:lll // url
+		Message: fmt.Sprintf("%s %q for struct %q should be placed in the file of the struct declaration %q",
+			kind, fn.Name, structSpec.Name, structFile),
+	})
+}
+
+func reportDeclNotInFileLayout(pass *analysis.Pass, decl ast.Decl, declDesc, laterDeclDesc, layout string) {
+	pass.Report(analysis.Diagnostic{
+		Pos: decl.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-the-file-layout",
+		Message: fmt.Sprintf("%s should be placed before %s, following the file layout %q",
+			declDesc, laterDeclDesc, layout),
+	})
+}
+
+func reportUnexportedDeclBeforeExportedDecl(pass *analysis.Pass, unexportedDecl, exportedDecl ast.Decl) {
+	pass.Report(analysis.Diagnostic{
+		Pos: unexportedDecl.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-exported-declarations-are-placed-before-unexported-declarations", //nolint:lll // url
+		Message: fmt.Sprintf("unexported %s should be placed after the exported %s",
+			describeDecl(unexportedDecl), describeDecl(exportedDecl)),
+	})
+}
+
+func reportUnexportedTypeBeforeExportedType(pass *analysis.Pass, unexportedType, exportedType *ast.TypeSpec) {
+	pass.Report(analysis.Diagnostic{
+		Pos: unexportedType.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-the-order-of-the-types",
+		Message: fmt.Sprintf("unexported type %q should be placed after the exported type %q",
+			unexportedType.Name, exportedType.Name),
+	})
+}
+
+func reportTypeNotSortedAlphabetically(pass *analysis.Pass, typeSpec, neighbour *ast.TypeSpec, after bool) {
+	pass.Report(analysis.Diagnostic{
+		Pos: typeSpec.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-the-order-of-the-types",
+		Message: fmt.Sprintf("type %q should be placed %s type %q",
+			typeSpec.Name, placement(after), neighbour.Name),
+	})
+}
+
+func reportTypeBlockNotInTypeOrder(
+	pass *analysis.Pass,
+	structSpec, previousStructSpec *ast.TypeSpec,
+	firstFunc *ast.FuncDecl,
+) {
+	pass.Report(analysis.Diagnostic{
+		Pos: firstFunc.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-the-order-of-the-types",
+		Message: fmt.Sprintf("constructors and methods of struct %q should be placed after the ones of struct %q, "+
+			"following the order of the type declarations", structSpec.Name, previousStructSpec.Name),
+	})
+}
+
+func reportFuncNotAfterCaller(pass *analysis.Pass, fn, caller *ast.FuncDecl) {
+	pass.Report(analysis.Diagnostic{
+		Pos: fn.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-the-stepdown-rule",
+		Message: fmt.Sprintf("%s should be placed after its caller %s",
+			describeDecl(fn), describeDecl(caller)),
+	})
+}
+
+func reportFuncNotBeforeCaller(pass *analysis.Pass, fn, caller *ast.FuncDecl) {
+	pass.Report(analysis.Diagnostic{
+		Pos: fn.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-the-stepdown-rule",
+		Message: fmt.Sprintf("%s should be placed before its caller %s",
+			describeDecl(fn), describeDecl(caller)),
+	})
+}
+
+func reportHelperNotAfterCaller(pass *analysis.Pass, helper, caller *ast.FuncDecl) {
+	pass.Report(analysis.Diagnostic{
+		Pos: helper.Pos(),
+		URL: "https://github.com/manuelarte/funcorder?tab=readme-ov-file#check-the-helpers-are-placed-after-their-caller",
+		Message: fmt.Sprintf("%s should be placed right after its only caller %s",
+			describeDecl(helper), describeDecl(caller)),
+	})
+}
+
+// receiverKind returns the kind of the receiver of the method.
+func receiverKind(method *ast.FuncDecl) string {
+	if hasPointerReceiver(method) {
+		return "pointer receiver"
+	}
+
+	return "value receiver"
+}
+
+// placement returns where a declaration is placed relative to its neighbour.
+func placement(after bool) string {
+	if after {
+		return "after"
+	}
+
+	return "before"
+}
+
+// describeDecl returns a short description of the declaration used in the messages, e.g. `function "helper"`.
+func describeDecl(decl ast.Decl) string {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Recv != nil {
+			return fmt.Sprintf("method %q", d.Name)
+		}
+
+		return fmt.Sprintf("function %q", d.Name)
+
+	case *ast.GenDecl:
+		if len(d.Specs) == 0 {
+			return d.Tok.String()
+		}
+
+		switch spec := d.Specs[0].(type) {
+		case *ast.TypeSpec:
+			return fmt.Sprintf("type %q", spec.Name)
+
+		case *ast.ValueSpec:
+			return fmt.Sprintf("%s %q", d.Tok, spec.Names[0])
+		}
+
+		return d.Tok.String()
+
+	default:
+		return "declaration"
+	}
+}
@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Section is a kind of top level declaration, used to configure the layout of the file.
+type Section string
+
+const (
+	ImportSection      Section = "import"
+	ConstSection       Section = "const"
+	VarSection         Section = "var"
+	TypeSection        Section = "type"
+	ConstructorSection Section = "constructor"
+	MethodSection      Section = "method"
+	FuncSection        Section = "func"
+)
+
+// Sections returns all the sections, in the usual order of a Go file.
+func Sections() []Section {
+	return []Section{
+		ImportSection, ConstSection, VarSection, TypeSection, ConstructorSection, MethodSection, FuncSection,
+	}
+}
+
+// analyzeFileLayout reports the first declaration of the file that is placed after a declaration of a later section.
+// The declarations of the sections not included in the layout are not checked,
+// and the constructors and methods are part of the type section if their sections are not included.
+func (fp *FileProcessor) analyzeFileLayout(pass *analysis.Pass) {
+	if fp.file == nil {
+		return
+	}
+
+	var (
+		last      ast.Decl
+		lastIndex = -1
+	)
+
+	for _, decl := range fp.file.Decls {
+		index := slices.Index(fp.settings.FileLayout, fp.section(decl))
+		if index < 0 {
+			continue
+		}
+
+		if index < lastIndex {
+			reportDeclNotInFileLayout(pass, decl, fp.describeDecl(decl), fp.describeDecl(last),
+				joinSections(fp.settings.FileLayout))
+
+			return
+		}
+
+		if index > lastIndex {
+			last, lastIndex = decl, index
+		}
+	}
+}
+
+// section returns the section of the declaration in the file layout.
+func (fp *FileProcessor) section(decl ast.Decl) Section {
+	switch d := decl.(type) {
+	case *ast.GenDecl:
+		switch d.Tok {
+		case token.IMPORT:
+			return ImportSection
+		case token.CONST:
+			return ConstSection
+		case token.VAR:
+			return VarSection
+		default:
+			return TypeSection
+		}
+
+	case *ast.FuncDecl:
+		if d.Recv != nil {
+			return fp.typeMemberSection(MethodSection)
+		}
+
+		if fp.isConstructorOfFileType(d) {
+			return fp.typeMemberSection(ConstructorSection)
+		}
+
+		return FuncSection
+
+	default:
+		return ""
+	}
+}
+
+// typeMemberSection returns the section of the constructors or methods,
+// which are part of the type section if their own section is not included in the layout.
+func (fp *FileProcessor) typeMemberSection(section Section) Section {
+	if slices.Contains(fp.settings.FileLayout, section) {
+		return section
+	}
+
+	return TypeSection
+}
+
+// isConstructorOfFileType checks whether the function is a constructor of a type declared in the file.
+func (fp *FileProcessor) isConstructorOfFileType(fn *ast.FuncDecl) bool {
+	for _, sh := range fp.structs {
+		if sh.Struct != nil && slices.Contains(sh.Constructors, fn) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// describeDecl returns a short description of the declaration, e.g. `constructor "NewServer"`.
+func (fp *FileProcessor) describeDecl(decl ast.Decl) string {
+	if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fp.isConstructorOfFileType(fn) {
+		return fmt.Sprintf("constructor %q", fn.Name)
+	}
+
+	return describeDecl(decl)
+}
+
+func joinSections(sections []Section) string {
+	names := make([]string, 0, len(sections))
+	for _, s := range sections {
+		names = append(names, string(s))
+	}
+
+	return strings.Join(names, ", ")
+}
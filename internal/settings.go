@@ -0,0 +1,112 @@
+package internal
+
+import "regexp"
+
+// Settings contains the enabled features and the options of the checks.
+type Settings struct {
+	// The features to be analyzed
+	Features Feature
+
+	// The patterns that the name of a function has to start with to be considered a constructor
+	ConstructorPatterns []*regexp.Regexp
+
+	// The groups of constructors, in order, e.g. `New`, then `NewWith` and then `Must`
+	ConstructorOrder []*regexp.Regexp
+
+	// How the names are compared by the alphabetical checks
+	NameComparator NameComparator
+
+	// Whether the exported, or the unexported, methods and functions are placed first
+	VisibilityOrder VisibilityOrder
+
+	// The qualified names of the interfaces whose methods are kept together, e.g. `io.Reader`,
+	// in addition to the interfaces declared in the package
+	Interfaces []string
+
+	// Whether the value receiver, or the pointer receiver, methods are placed first, if checked
+	ReceiverKindOrder ReceiverKindOrder
+
+	// Where the constructors are placed relative to their struct declaration
+	ConstructorPlacement ConstructorPlacement
+
+	// The expected order of the sections of the file, e.g. `const`, `var`, `type` and then `func`
+	FileLayout []Section
+
+	// The direction of the calls between the functions of the file, if checked
+	Stepdown StepdownDirection
+
+	// Where the unexported helpers are placed, if checked
+	HelperPlacement HelperPlacement
+
+	// Where the constructors and methods of a type declared in another file of the package are checked
+	CrossFile CrossFilePolicy
+}
+
+// VisibilityOrder is whether the exported, or the unexported, methods and functions are placed first.
+type VisibilityOrder string
+
+const (
+	// VisibilityExportedFirst places the exported methods and functions first, the public API is read first.
+	VisibilityExportedFirst VisibilityOrder = "exported-first"
+	// VisibilityUnexportedFirst places the unexported methods and functions first, the building blocks are read first.
+	VisibilityUnexportedFirst VisibilityOrder = "unexported-first"
+)
+
+// ReceiverKindOrder is whether the value receiver, or the pointer receiver, methods of a struct are placed first.
+type ReceiverKindOrder string
+
+const (
+	// ReceiverKindNone doesn't check the order of the receiver kinds.
+	ReceiverKindNone ReceiverKindOrder = "none"
+	// ReceiverKindValueFirst places the value receiver methods first, the read-only API is read first.
+	ReceiverKindValueFirst ReceiverKindOrder = "value-first"
+	// ReceiverKindPointerFirst places the pointer receiver methods first.
+	ReceiverKindPointerFirst ReceiverKindOrder = "pointer-first"
+)
+
+// ConstructorPlacement is where the constructors are placed relative to their struct declaration.
+type ConstructorPlacement string
+
+const (
+	// ConstructorAfterStruct places the constructors anywhere after the struct declaration, before its methods.
+	ConstructorAfterStruct ConstructorPlacement = "after-struct"
+	// ConstructorAdjacent places the constructors directly after the struct declaration,
+	// only the consts and vars related to the struct can be placed in between.
+	ConstructorAdjacent ConstructorPlacement = "adjacent"
+)
+
+// StepdownDirection is the expected direction of the calls between the functions of the file.
+type StepdownDirection string
+
+const (
+	// StepdownNone doesn't check the direction of the calls.
+	StepdownNone StepdownDirection = "none"
+	// StepdownCallerFirst places the functions after their first caller, like a newspaper.
+	StepdownCallerFirst StepdownDirection = "caller-first"
+	// StepdownCalleeFirst places the functions before their last caller.
+	StepdownCalleeFirst StepdownDirection = "callee-first"
+)
+
+// HelperPlacement is where the unexported functions and methods are placed relative to their callers.
+type HelperPlacement string
+
+const (
+	// HelperPlacementNone doesn't check where the helpers are placed.
+	HelperPlacementNone HelperPlacement = "none"
+	// HelperPlacementAfterCaller places a helper with only one caller right after it, or after its other helpers.
+	HelperPlacementAfterCaller HelperPlacement = "after-caller"
+	// HelperPlacementBottom places the unexported functions after the last exported function, like the function check.
+	HelperPlacementBottom HelperPlacement = "bottom"
+)
+
+// CrossFilePolicy is the policy applied to the constructors and methods declared in another file than their type.
+type CrossFilePolicy string
+
+const (
+	// CrossFileNone ignores the constructors and methods declared in another file than their type.
+	CrossFileNone CrossFilePolicy = "none"
+	// CrossFileSameFile reports the constructors and methods declared in another file than their type.
+	CrossFileSameFile CrossFilePolicy = "same-file"
+	// CrossFilePerFile checks the order of the constructors and methods in each file they are declared.
+	CrossFilePerFile CrossFilePolicy = "per-file"
+)
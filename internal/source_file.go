@@ -0,0 +1,230 @@
+package internal
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// sourceFile holds the content of a Go file, so declarations can be moved around as plain text.
+type sourceFile struct {
+	file    *ast.File
+	tokFile *token.File
+	content []byte
+}
+
+// newSourceFile returns the source file that contains pos, or nil if its content can't be read.
+func newSourceFile(pass *analysis.Pass, pos token.Pos) *sourceFile {
+	if pass.ReadFile == nil {
+		return nil
+	}
+
+	tokFile := pass.Fset.File(pos)
+	if tokFile == nil {
+		return nil
+	}
+
+	var file *ast.File
+
+	for _, f := range pass.Files {
+		if f.FileStart <= pos && pos <= f.FileEnd {
+			file = f
+
+			break
+		}
+	}
+
+	if file == nil {
+		return nil
+	}
+
+	content, err := pass.ReadFile(tokFile.Name())
+	if err != nil || len(content) != tokFile.Size() {
+		return nil
+	}
+
+	return &sourceFile{
+		file:    file,
+		tokFile: tokFile,
+		content: content,
+	}
+}
+
+// apply returns a copy of the content with the edits applied, the edits must be sorted and not overlap.
+func (sf *sourceFile) apply(edits []analysis.TextEdit) []byte {
+	var (
+		out  []byte
+		last int
+	)
+
+	for _, edit := range edits {
+		start, end := sf.tokFile.Offset(edit.Pos), sf.tokFile.Offset(edit.End)
+		out = append(out, sf.content[last:start]...)
+		out = append(out, edit.NewText...)
+		last = end
+	}
+
+	return append(out, sf.content[last:]...)
+}
+
+// enclosingDecl returns the top level declaration that contains the node, e.g. the `type (...)` block of a type spec.
+func (sf *sourceFile) enclosingDecl(n ast.Node) ast.Decl {
+	for _, decl := range sf.file.Decls {
+		if decl.Pos() <= n.Pos() && n.End() <= decl.End() {
+			return decl
+		}
+	}
+
+	return nil
+}
+
+// moveAfter returns the edits that move decl to just after target.
+func (sf *sourceFile) moveAfter(decl, target ast.Decl) []analysis.TextEdit {
+	_, insertAt := sf.declSpan(target)
+
+	text := sf.declText(decl)
+	if insertAt > 0 && sf.content[insertAt-1] != '\n' {
+		text = append([]byte("\n"), text...)
+	}
+
+	return []analysis.TextEdit{
+		sf.removeEdit(decl),
+		{
+			Pos:     sf.tokFile.Pos(insertAt),
+			End:     sf.tokFile.Pos(insertAt),
+			NewText: append([]byte("\n"), text...),
+		},
+	}
+}
+
+// moveBefore returns the edits that move decl to just before target.
+func (sf *sourceFile) moveBefore(decl, target ast.Decl) []analysis.TextEdit {
+	insertAt, _ := sf.declSpan(target)
+
+	return []analysis.TextEdit{
+		{
+			Pos:     sf.tokFile.Pos(insertAt),
+			End:     sf.tokFile.Pos(insertAt),
+			NewText: append(sf.declText(decl), '\n'),
+		},
+		sf.removeEdit(decl),
+	}
+}
+
+// reorder returns the edits that place, in the position of each current declaration,
+// the declaration at the same index in wanted.
+// current must be sorted by position and both slices must contain the same declarations.
+func reorder[T ast.Decl](sf *sourceFile, current, wanted []T) []analysis.TextEdit {
+	var edits []analysis.TextEdit
+
+	for i, decl := range current {
+		if ast.Decl(decl) == ast.Decl(wanted[i]) {
+			continue
+		}
+
+		start, end := sf.declSpan(decl)
+
+		text := sf.declText(wanted[i])
+		if sf.content[end-1] != '\n' {
+			text = text[:len(text)-1]
+		}
+
+		edits = append(edits, analysis.TextEdit{
+			Pos:     sf.tokFile.Pos(start),
+			End:     sf.tokFile.Pos(end),
+			NewText: text,
+		})
+	}
+
+	return edits
+}
+
+// removeEdit returns the edit that deletes decl, together with one of the blank lines that surround it.
+func (sf *sourceFile) removeEdit(decl ast.Decl) analysis.TextEdit {
+	start, end := sf.declSpan(decl)
+
+	if next := lineEnd(sf.content, end); next > end && isBlank(sf.content[end:next]) {
+		end = next
+	} else if start > 0 {
+		if prev := lineStart(sf.content, start-1); isBlank(sf.content[prev:start]) {
+			start = prev
+		}
+	}
+
+	return analysis.TextEdit{
+		Pos: sf.tokFile.Pos(start),
+		End: sf.tokFile.Pos(end),
+	}
+}
+
+// declText returns a copy of the text of the declaration, including its comments and trailing new line.
+func (sf *sourceFile) declText(decl ast.Decl) []byte {
+	start, end := sf.declSpan(decl)
+
+	text := bytes.Clone(sf.content[start:end])
+	if len(text) > 0 && text[len(text)-1] != '\n' {
+		text = append(text, '\n')
+	}
+
+	return text
+}
+
+// declSpan returns the offsets of the whole lines that the declaration spans,
+// including its doc comment, compiler (`//go:`) and `//nolint` directives, and its trailing line comment.
+func (sf *sourceFile) declSpan(decl ast.Decl) (int, int) {
+	start := sf.tokFile.Offset(decl.Pos())
+	if doc := declDoc(decl); doc != nil {
+		start = sf.tokFile.Offset(doc.Pos())
+	}
+
+	if ls := lineStart(sf.content, start); isBlank(sf.content[ls:start]) {
+		start = ls
+	}
+
+	end := sf.tokFile.Offset(decl.End())
+	if le := lineEnd(sf.content, end); isBlankOrComment(sf.content[end:le]) {
+		end = le
+	}
+
+	return start, end
+}
+
+func declDoc(decl ast.Decl) *ast.CommentGroup {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		return d.Doc
+
+	case *ast.GenDecl:
+		return d.Doc
+
+	default:
+		return nil
+	}
+}
+
+// lineStart returns the offset of the beginning of the line that contains offset.
+func lineStart(content []byte, offset int) int {
+	return bytes.LastIndexByte(content[:offset], '\n') + 1
+}
+
+// lineEnd returns the offset just after the new line character that ends the line that contains offset.
+func lineEnd(content []byte, offset int) int {
+	i := bytes.IndexByte(content[offset:], '\n')
+	if i < 0 {
+		return len(content)
+	}
+
+	return offset + i + 1
+}
+
+func isBlank(b []byte) bool {
+	return len(bytes.TrimSpace(b)) == 0
+}
+
+func isBlankOrComment(b []byte) bool {
+	trimmed := bytes.TrimSpace(b)
+
+	return len(trimmed) == 0 || bytes.HasPrefix(trimmed, []byte("//"))
+}
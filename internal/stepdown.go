@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"golang.org/x/tools/go/analysis"
+)
+
+// analyzeStepdown checks the direction of the calls between the functions and methods of the file.
+// With caller-first, a function is placed after its first caller,
+// and with callee-first, a function is placed before its last caller.
+// The calls that close a cycle are ignored, see callGraph.acyclic.
+func (fp *FileProcessor) analyzeStepdown(pass *analysis.Pass) {
+	g := newCallGraph(fp.funcDecls, fp.typesInfo).acyclic()
+
+	for _, fn := range g.funcs {
+		callers := g.callers(fn)
+		if len(callers) == 0 {
+			continue
+		}
+
+		switch fp.settings.Stepdown {
+		case StepdownCallerFirst:
+			if first := callers[0]; fn.Pos() < first.Pos() {
+				reportFuncNotAfterCaller(pass, fn, first)
+			}
+
+		case StepdownCalleeFirst:
+			if last := callers[len(callers)-1]; fn.Pos() > last.Pos() {
+				reportFuncNotBeforeCaller(pass, fn, last)
+			}
+
+		case StepdownNone:
+		}
+	}
+}
@@ -2,7 +2,8 @@ package internal
 
 import (
 	"go/ast"
-	"strings"
+	"go/types"
+	"regexp"
 )
 
 type StructConstructor struct {
@@ -10,8 +11,8 @@ type StructConstructor struct {
 	StructReturn *ast.Ident
 }
 
-func NewStructConstructor(funcDec *ast.FuncDecl) *StructConstructor {
-	if !funcCanBeConstructor(funcDec) {
+func NewStructConstructor(funcDec *ast.FuncDecl, patterns []*regexp.Regexp) *StructConstructor {
+	if !funcCanBeConstructor(funcDec, patterns) {
 		return nil
 	}
 
@@ -28,8 +29,66 @@ func NewStructConstructor(funcDec *ast.FuncDecl) *StructConstructor {
 	}
 }
 
-func funcCanBeConstructor(n *ast.FuncDecl) bool {
-	if !n.Name.IsExported() || n.Recv != nil {
+// NewTypedStructConstructor creates a struct constructor if the 1st result of the function is a named type,
+// or a pointer to it, declared in the package, whatever the name of the function is.
+// Without type information, the name of the 1st result type is used.
+func NewTypedStructConstructor(funcDec *ast.FuncDecl, pkg *types.Package, info *types.Info) *StructConstructor {
+	if funcDec.Recv != nil {
+		return nil
+	}
+
+	if funcDec.Type.Results == nil || len(funcDec.Type.Results.List) == 0 {
+		return nil
+	}
+
+	expr := funcDec.Type.Results.List[0].Type
+
+	if pkg == nil || info == nil {
+		returnType := getIdent(expr)
+		if returnType == nil {
+			return nil
+		}
+
+		return &StructConstructor{
+			Constructor:  funcDec,
+			StructReturn: returnType,
+		}
+	}
+
+	named := namedType(info.TypeOf(expr))
+	if named == nil || named.Obj().Pkg() != pkg || named.Obj().Parent() != pkg.Scope() {
+		return nil
+	}
+
+	return &StructConstructor{
+		Constructor: funcDec,
+		StructReturn: &ast.Ident{
+			NamePos: expr.Pos(),
+			Name:    named.Obj().Name(),
+		},
+	}
+}
+
+// namedType returns the named type, dereferencing pointers and resolving aliases.
+func namedType(t types.Type) *types.Named {
+	if t == nil {
+		return nil
+	}
+
+	if ptr, ok := types.Unalias(t).(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, _ := types.Unalias(t).(*types.Named)
+
+	return named
+}
+
+// funcCanBeConstructor checks whether the function name starts with one of the patterns, e.g. `New` or `Must`,
+// the name can also be just the pattern, e.g. `errors.New`.
+// Unexported functions, e.g. `newServer`, are also considered.
+func funcCanBeConstructor(n *ast.FuncDecl, patterns []*regexp.Regexp) bool {
+	if n.Recv != nil {
 		return false
 	}
 
@@ -37,9 +96,20 @@ func funcCanBeConstructor(n *ast.FuncDecl) bool {
 		return false
 	}
 
-	for _, prefix := range []string{"new", "must"} {
-		if strings.HasPrefix(strings.ToLower(n.Name.Name), prefix) &&
-			len(n.Name.Name) > len(prefix) { // TODO(ldez): bug if the name is just `New`.
+	for _, pattern := range patterns {
+		if loc := pattern.FindStringIndex(n.Name.Name); loc != nil && loc[0] == 0 && loc[1] > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isBareConstructor checks whether the whole function name is matched by one of the patterns, e.g. `New` or `Must`.
+// A bare constructor is the constructor of the type it returns, it's usually found in single type packages.
+func isBareConstructor(n *ast.FuncDecl, patterns []*regexp.Regexp) bool {
+	for _, pattern := range patterns {
+		if loc := pattern.FindStringIndex(n.Name.Name); loc != nil && loc[0] == 0 && loc[1] == len(n.Name.Name) {
 			return true
 		}
 	}
@@ -3,7 +3,9 @@ package internal
 import (
 	"cmp"
 	"go/ast"
+	"regexp"
 	"slices"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
 )
@@ -16,21 +18,40 @@ type StructHolder struct {
 	// The struct declaration
 	Struct *ast.TypeSpec
 
+	// Whether the struct is declared in another file of the package, see CrossFilePerFile
+	StructInOtherFile bool
+
 	// A Struct constructor is considered if starts with `New...` and the 1st output parameter is a struct
 	Constructors []*ast.FuncDecl
 
+	// The constructors whose name is just a constructor prefix, e.g. `New` or `Must`
+	BareConstructors []*ast.FuncDecl
+
+	// The groups of constructors, in order, e.g. `New`, then `NewWith` and then `Must`
+	ConstructorOrder []*regexp.Regexp
+
+	// How the names are compared by the alphabetical checks
+	NameComparator NameComparator
+
+	// Whether the exported, or the unexported, methods are placed first
+	VisibilityOrder VisibilityOrder
+
+	// Whether the value receiver, or the pointer receiver, methods are placed first, if checked
+	ReceiverKindOrder ReceiverKindOrder
+
 	// Struct methods
 	StructMethods []*ast.FuncDecl
 }
 
 // Analyze applies the linter to the struct holder.
 func (sh *StructHolder) Analyze(pass *analysis.Pass) {
-	// TODO maybe sort constructors and then report also, like NewXXX before MustXXX
 	slices.SortFunc(sh.StructMethods, func(a, b *ast.FuncDecl) int {
 		return cmp.Compare(a.Pos(), b.Pos())
 	})
 
-	// TODO also check that the methods are declared after the struct
+	if sh.Features.IsEnabled(MethodAfterStructCheck) {
+		sh.analyzeMethodAfterStruct(pass)
+	}
 
 	if sh.Features.IsEnabled(ConstructorCheck) {
 		sh.analyzeConstructor(pass)
@@ -42,66 +63,260 @@ func (sh *StructHolder) Analyze(pass *analysis.Pass) {
 }
 
 func (sh *StructHolder) analyzeConstructor(pass *analysis.Pass) {
-	for i, constructor := range sh.Constructors {
-		if constructor.Pos() < sh.Struct.Pos() {
-			reportConstructorNotAfterStructType(pass, sh.Struct, constructor)
+	for _, constructor := range sh.Constructors {
+		if sh.isBeforeStruct(constructor) {
+			reportConstructorNotAfterStructType(pass, sh.Struct, constructor,
+				moveConstructorAfterStructFix(pass, sh.Struct, constructor))
 		}
 
 		if len(sh.StructMethods) > 0 && constructor.Pos() > sh.StructMethods[0].Pos() {
-			reportConstructorNotBeforeStructMethod(pass, sh.Struct, constructor, sh.StructMethods[0])
+			reportConstructorNotBeforeStructMethod(pass, sh.Struct, constructor, sh.StructMethods[0],
+				sh.constructorNotBeforeStructMethodFix(pass, constructor))
 		}
+	}
+
+	for _, bare := range sh.BareConstructors {
+		for _, c := range sh.Constructors {
+			if c.Pos() < bare.Pos() && !slices.Contains(sh.BareConstructors, c) &&
+				strings.HasPrefix(c.Name.Name, bare.Name.Name) {
+				reportBareConstructorNotFirst(pass, sh.Struct, bare, c)
+
+				break
+			}
+		}
+	}
+
+	exported, unexported := splitExportedUnexported(sh.Constructors)
+	if len(exported) > 0 {
+		lastExportedConstructor := exported[len(exported)-1]
+
+		for _, c := range unexported {
+			if c.Pos() < lastExportedConstructor.Pos() {
+				reportUnexportedConstructorBeforeExportedForStruct(pass, sh.Struct, c, lastExportedConstructor)
+			}
+		}
+	}
+
+	if len(sh.ConstructorOrder) > 0 {
+		sh.analyzeConstructorOrder(pass, exported)
+		sh.analyzeConstructorOrder(pass, unexported)
+
+		return
+	}
 
-		if sh.Features.IsEnabled(AlphabeticalCheck) &&
-			i < len(sh.Constructors)-1 && sh.Constructors[i].Name.Name > sh.Constructors[i+1].Name.Name {
-			reportAdjacentConstructorsNotSortedAlphabetically(pass, sh.Struct, sh.Constructors[i], sh.Constructors[i+1])
+	if sh.Features.IsEnabled(AlphabeticalConstructors) {
+		sh.sortConstructorsDiagnostics(pass, exported)
+		sh.sortConstructorsDiagnostics(pass, unexported)
+	}
+}
+
+// sortConstructorsDiagnostics reports the fewest constructors that have to be moved to sort them alphabetically.
+func (sh *StructHolder) sortConstructorsDiagnostics(pass *analysis.Pass, constructors []*ast.FuncDecl) {
+	for _, m := range misplacements(constructors, sh.compareNames) {
+		reportConstructorNotSortedAlphabetically(pass, sh.Struct, m.item, m.neighbour, m.after)
+	}
+}
+
+// constructorNotBeforeStructMethodFix returns the fix that moves the constructor before the first struct method.
+// No fix is returned if the constructor is also before the struct, the fix that places it after the struct is used.
+// If the first struct method is declared before the struct, the constructor is placed after the struct instead.
+func (sh *StructHolder) constructorNotBeforeStructMethodFix(
+	pass *analysis.Pass,
+	constructor *ast.FuncDecl,
+) []analysis.SuggestedFix {
+	if sh.isBeforeStruct(constructor) {
+		return nil
+	}
+
+	if sh.isBeforeStruct(sh.StructMethods[0]) {
+		return moveConstructorAfterStructFix(pass, sh.Struct, constructor)
+	}
+
+	return moveConstructorBeforeMethodFix(pass, sh.Struct, constructor, sh.StructMethods[0])
+}
+
+func (sh *StructHolder) analyzeMethodAfterStruct(pass *analysis.Pass) {
+	for _, m := range sh.StructMethods {
+		if sh.isBeforeStruct(m) {
+			reportMethodNotAfterStructType(pass, sh.Struct, m,
+				moveMethodAfterStructFix(pass, sh.Struct, m, sh.Constructors, sh.StructMethods))
 		}
 	}
 }
 
 func (sh *StructHolder) analyzeStructMethod(pass *analysis.Pass) {
-	var lastExportedMethod *ast.FuncDecl
+	unexportedFirst := sh.VisibilityOrder == VisibilityUnexportedFirst
+
+	var lastFirstMethod *ast.FuncDecl
 
 	for _, m := range sh.StructMethods {
-		if !m.Name.IsExported() {
+		if m.Name.IsExported() == unexportedFirst {
 			continue
 		}
 
-		if lastExportedMethod == nil {
-			lastExportedMethod = m
+		if lastFirstMethod == nil || lastFirstMethod.Pos() < m.Pos() {
+			lastFirstMethod = m
 		}
+	}
 
-		if lastExportedMethod.Pos() < m.Pos() {
-			lastExportedMethod = m
-		}
+	var fixes []analysis.SuggestedFix
+	if sorted := sh.sortedStructMethods(); !slices.Equal(sorted, sh.StructMethods) {
+		fixes = sortStructMethodsFix(pass, sh.Struct, sh.StructMethods, sorted)
 	}
 
-	if lastExportedMethod != nil {
+	if lastFirstMethod != nil {
 		for _, m := range sh.StructMethods {
-			if m.Name.IsExported() || m.Pos() >= lastExportedMethod.Pos() {
+			if m.Name.IsExported() != unexportedFirst || m.Pos() >= lastFirstMethod.Pos() {
 				continue
 			}
 
-			reportUnexportedMethodBeforeExportedForStruct(pass, sh.Struct, m, lastExportedMethod)
+			if unexportedFirst {
+				reportExportedMethodBeforeUnexportedForStruct(pass, sh.Struct, m, lastFirstMethod, fixes)
+			} else {
+				reportUnexportedMethodBeforeExportedForStruct(pass, sh.Struct, m, lastFirstMethod, fixes)
+			}
 		}
 	}
 
-	if sh.Features.IsEnabled(AlphabeticalCheck) {
-		exported, unexported := splitExportedUnexported(sh.StructMethods)
-		sh.sortDiagnostics(pass, exported)
-		sh.sortDiagnostics(pass, unexported)
+	exported, unexported := splitExportedUnexported(sh.StructMethods)
+	for _, methods := range [][]*ast.FuncDecl{exported, unexported} {
+		groups := sh.receiverKindGroups(methods)
+		if len(groups) > 1 {
+			sh.analyzeReceiverKind(pass, groups[0], groups[1], fixes)
+		}
+
+		if sh.Features.IsEnabled(AlphabeticalMethods) {
+			for _, group := range groups {
+				sh.sortDiagnostics(pass, group, fixes)
+			}
+		}
 	}
 }
 
-func (sh *StructHolder) sortDiagnostics(pass *analysis.Pass, funcDecls []*ast.FuncDecl) {
-	for i := range funcDecls {
-		if i >= len(funcDecls)-1 {
-			continue
+// analyzeReceiverKind reports the methods of the second receiver kind placed before the last method of the first one.
+func (sh *StructHolder) analyzeReceiverKind(
+	pass *analysis.Pass,
+	first, second []*ast.FuncDecl,
+	fixes []analysis.SuggestedFix,
+) {
+	if len(first) == 0 {
+		return
+	}
+
+	lastFirst := first[len(first)-1]
+	for _, m := range second {
+		if m.Pos() < lastFirst.Pos() {
+			reportReceiverKindNotSorted(pass, sh.Struct, m, lastFirst, fixes)
 		}
+	}
+}
+
+// receiverKindGroups splits the methods by receiver kind, in the configured order,
+// or returns them in a single group if the order of the receiver kinds is not checked.
+func (sh *StructHolder) receiverKindGroups(methods []*ast.FuncDecl) [][]*ast.FuncDecl {
+	var values, pointers []*ast.FuncDecl
 
-		if funcDecls[i].Name.Name > funcDecls[i+1].Name.Name {
-			reportAdjacentStructMethodsNotSortedAlphabetically(pass, sh.Struct, funcDecls[i], funcDecls[i+1])
+	for _, m := range methods {
+		if hasPointerReceiver(m) {
+			pointers = append(pointers, m)
+		} else {
+			values = append(values, m)
 		}
 	}
+
+	switch sh.ReceiverKindOrder {
+	case ReceiverKindValueFirst:
+		return [][]*ast.FuncDecl{values, pointers}
+	case ReceiverKindPointerFirst:
+		return [][]*ast.FuncDecl{pointers, values}
+	default:
+		return [][]*ast.FuncDecl{methods}
+	}
+}
+
+// sortedConstructors returns the constructors in the order expected by the enabled features,
+// exported constructors first and then, if enabled, alphabetically within each group.
+// Otherwise, the bare constructors, e.g. `New`, are placed first in each group.
+func (sh *StructHolder) sortedConstructors() []*ast.FuncDecl {
+	exported, unexported := splitExportedUnexported(sh.Constructors)
+
+	compare := func(a, b *ast.FuncDecl) int {
+		aBare, bBare := slices.Contains(sh.BareConstructors, a), slices.Contains(sh.BareConstructors, b)
+
+		switch {
+		case aBare && !bBare:
+			return -1
+		case !aBare && bBare:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	if sh.Features.IsEnabled(AlphabeticalConstructors) {
+		compare = func(a, b *ast.FuncDecl) int {
+			return sh.compareNames(a, b)
+		}
+	}
+
+	if len(sh.ConstructorOrder) > 0 {
+		return append(sh.sortedConstructorsByGroup(exported, compare), sh.sortedConstructorsByGroup(unexported, compare)...)
+	}
+
+	slices.SortStableFunc(exported, compare)
+	slices.SortStableFunc(unexported, compare)
+
+	return append(exported, unexported...)
+}
+
+// sortedStructMethods returns the struct methods in the order expected by the enabled features,
+// exported methods first, or unexported methods first if configured, then by receiver kind if configured,
+// and then, if enabled, alphabetically within each group.
+func (sh *StructHolder) sortedStructMethods() []*ast.FuncDecl {
+	exported, unexported := splitExportedUnexported(sh.StructMethods)
+	exported, unexported = sh.sortedByReceiverKind(exported), sh.sortedByReceiverKind(unexported)
+
+	if sh.VisibilityOrder == VisibilityUnexportedFirst {
+		return append(unexported, exported...)
+	}
+
+	return append(exported, unexported...)
+}
+
+// sortedByReceiverKind returns the methods grouped by receiver kind, if configured,
+// and then, if enabled, sorted alphabetically within each group.
+func (sh *StructHolder) sortedByReceiverKind(methods []*ast.FuncDecl) []*ast.FuncDecl {
+	out := make([]*ast.FuncDecl, 0, len(methods))
+
+	for _, group := range sh.receiverKindGroups(methods) {
+		if sh.Features.IsEnabled(AlphabeticalMethods) {
+			slices.SortStableFunc(group, sh.compareNames)
+		}
+
+		out = append(out, group...)
+	}
+
+	return out
+}
+
+// sortDiagnostics reports the fewest methods that have to be moved to sort them alphabetically.
+func (sh *StructHolder) sortDiagnostics(
+	pass *analysis.Pass,
+	funcDecls []*ast.FuncDecl,
+	fixes []analysis.SuggestedFix,
+) {
+	for _, m := range misplacements(funcDecls, sh.compareNames) {
+		reportStructMethodNotSortedAlphabetically(pass, sh.Struct, m.item, m.neighbour, m.after, fixes)
+	}
+}
+
+func (sh *StructHolder) compareNames(a, b *ast.FuncDecl) int {
+	return sh.NameComparator.Compare(a.Name.Name, b.Name.Name)
+}
+
+// isBeforeStruct checks whether the node is placed before the struct declaration in the same file.
+func (sh *StructHolder) isBeforeStruct(n ast.Node) bool {
+	return !sh.StructInOtherFile && n.Pos() < sh.Struct.Pos()
 }
 
 // splitExportedUnexported split functions/methods based on whether they are exported or not.
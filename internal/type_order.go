@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"cmp"
+	"go/ast"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// analyzeTypeOrder checks the order of the types declared in the file, the exported types are placed before
+// the unexported ones, alphabetically if enabled, and the blocks of constructors and methods of the types
+// are placed in the same order as the type declarations.
+// The types declared inside a function are not checked.
+func (fp *FileProcessor) analyzeTypeOrder(pass *analysis.Pass) {
+	topLevel := fp.topLevelTypeSpecs()
+
+	var holders []*StructHolder
+
+	for _, sh := range fp.structs {
+		if sh.Struct != nil && topLevel[sh.Struct] {
+			holders = append(holders, sh)
+		}
+	}
+
+	slices.SortFunc(holders, func(a, b *StructHolder) int {
+		return cmp.Compare(a.Struct.Pos(), b.Struct.Pos())
+	})
+
+	var lastExported *ast.TypeSpec
+
+	for _, sh := range holders {
+		if sh.Struct.Name.IsExported() {
+			lastExported = sh.Struct
+		}
+	}
+
+	var exported, unexported []*ast.TypeSpec
+
+	for _, sh := range holders {
+		if sh.Struct.Name.IsExported() {
+			exported = append(exported, sh.Struct)
+
+			continue
+		}
+
+		unexported = append(unexported, sh.Struct)
+
+		if lastExported != nil && sh.Struct.Pos() < lastExported.Pos() {
+			reportUnexportedTypeBeforeExportedType(pass, sh.Struct, lastExported)
+		}
+	}
+
+	if fp.settings.Features.IsEnabled(AlphabeticalTypes) {
+		compare := func(a, b *ast.TypeSpec) int {
+			return fp.settings.NameComparator.Compare(a.Name.Name, b.Name.Name)
+		}
+
+		for _, types := range [][]*ast.TypeSpec{exported, unexported} {
+			for _, m := range misplacements(types, compare) {
+				reportTypeNotSortedAlphabetically(pass, m.item, m.neighbour, m.after)
+			}
+		}
+	}
+
+	var previous *StructHolder
+
+	for _, sh := range holders {
+		first := sh.firstFunc()
+		if first == nil {
+			continue
+		}
+
+		if previous != nil && first.Pos() < previous.firstFunc().Pos() {
+			reportTypeBlockNotInTypeOrder(pass, sh.Struct, previous.Struct, first)
+		}
+
+		previous = sh
+	}
+}
+
+// topLevelTypeSpecs returns the type specs of the top-level declarations of the file.
+func (fp *FileProcessor) topLevelTypeSpecs() map[*ast.TypeSpec]bool {
+	specs := make(map[*ast.TypeSpec]bool)
+
+	if fp.file == nil {
+		return specs
+	}
+
+	for _, decl := range fp.file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			if ts, isTypeSpec := spec.(*ast.TypeSpec); isTypeSpec {
+				specs[ts] = true
+			}
+		}
+	}
+
+	return specs
+}
+
+// firstFunc returns the first constructor or method of the struct, in source order.
+func (sh *StructHolder) firstFunc() *ast.FuncDecl {
+	var first *ast.FuncDecl
+
+	for _, fn := range slices.Concat(sh.Constructors, sh.StructMethods) {
+		if first == nil || fn.Pos() < first.Pos() {
+			first = fn
+		}
+	}
+
+	return first
+}
@@ -1,11 +1,17 @@
 package main
 
 import (
+	"os"
+
 	"golang.org/x/tools/go/analysis/singlechecker"
 
 	"github.com/manuelarte/funcorder/analyzer"
 )
 
 func main() {
+	if isRewriteMode(os.Args[1:]) {
+		os.Exit(rewriteMain(os.Args[1:]))
+	}
+
 	singlechecker.Main(analyzer.NewAnalyzer())
 }
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/manuelarte/funcorder/analyzer"
+)
+
+const (
+	writeFlagName = "w"
+	diffFlagName  = "d"
+)
+
+// rewriteFlags are the flags of the rewrite mode, the -w and -d flags and the flags of the checks.
+type rewriteFlags struct {
+	*flag.FlagSet
+
+	write bool
+	diff  bool
+}
+
+func newRewriteFlags(r *analyzer.Rewriter) *rewriteFlags {
+	flags := &rewriteFlags{
+		FlagSet: flag.NewFlagSet("funcorder", flag.ContinueOnError),
+	}
+
+	flags.BoolVar(&flags.write, writeFlagName, false, "Write the result to the source files instead of stdout.")
+	flags.BoolVar(&flags.diff, diffFlagName, false, "Display diffs instead of rewriting the files.")
+
+	r.Flags.VisitAll(func(f *flag.Flag) {
+		flags.Var(f.Value, f.Name, f.Usage)
+	})
+
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: funcorder [-w] [-d] [-flag] [file|directory|package ...]\n\n"+
+			"The packages are given by their import path, e.g. github.com/x/y or github.com/x/y/...\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+
+	return flags
+}
+
+// isRewriteMode checks whether the -w or -d flags are set, in that case the files are rewritten
+// instead of being analyzed.
+// The arguments are parsed with the flags of the rewrite mode, so the flags of the analyzer mode, e.g. -fix,
+// are not valid flags and the files are analyzed.
+func isRewriteMode(args []string) bool {
+	flags := newRewriteFlags(analyzer.NewRewriter())
+	flags.SetOutput(io.Discard)
+
+	if err := flags.Parse(args); err != nil {
+		return false
+	}
+
+	return flags.write || flags.diff
+}
+
+// rewriteMain rewrites the files, or the packages, in the canonical layout of the enabled checks,
+// and returns the exit code.
+func rewriteMain(args []string) int {
+	r := analyzer.NewRewriter()
+
+	flags := newRewriteFlags(r)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	exitCode := 0
+
+	for _, arg := range flags.Args() {
+		filenames, err := goFiles(arg)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+
+			exitCode = 1
+
+			continue
+		}
+
+		for _, filename := range filenames {
+			if err = rewriteFile(r, filename, flags.write, flags.diff); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+
+				exitCode = 1
+			}
+		}
+	}
+
+	return exitCode
+}
+
+func rewriteFile(r *analyzer.Rewriter, filename string, write, diff bool) error {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	out, err := r.Rewrite(filename, src)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(src, out) {
+		return nil
+	}
+
+	if diff {
+		fmt.Print(unifiedDiff(filename, src, out))
+	}
+
+	if !write {
+		return nil
+	}
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, out, info.Mode().Perm())
+}
+
+// goFiles returns the Go files of the argument, that can be a file, a directory or a pattern ending with `/...`,
+// or the import path of a package, that can also end with `/...`.
+func goFiles(arg string) ([]string, error) {
+	root, recursive := strings.CutSuffix(arg, "...")
+	if recursive {
+		root = filepath.Clean(root)
+	}
+
+	info, err := os.Stat(root)
+	if errors.Is(err, fs.ErrNotExist) && !isLocalPath(arg) {
+		return packageFiles(arg)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{root}, nil
+	}
+
+	var filenames []string
+
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+
+		if d.IsDir() {
+			if path != root && (!recursive || skipDir(d.Name())) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if strings.HasSuffix(path, ".go") {
+			filenames = append(filenames, path)
+		}
+
+		return nil
+	})
+
+	return filenames, err
+}
+
+// skipDir checks whether the directory is ignored by the go tool when matching `/...` patterns.
+func skipDir(name string) bool {
+	return name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")
+}
+
+// isLocalPath checks whether the argument is a path of the file system, not an import path.
+func isLocalPath(arg string) bool {
+	return filepath.IsAbs(arg) || arg == "." || arg == ".." ||
+		strings.HasPrefix(arg, "./") || strings.HasPrefix(arg, "../") || strings.HasSuffix(arg, ".go")
+}
+
+// packageFiles returns the Go files, test files included, of the packages matching the import path pattern.
+func packageFiles(pattern string) ([]string, error) {
+	cfg := &packages.Config{
+		Mode:  packages.NeedName | packages.NeedFiles,
+		Tests: true,
+	}
+
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var filenames []string
+
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, pkg.Errors[0]
+		}
+
+		// the generated test main package
+		if strings.HasSuffix(pkg.ID, ".test") {
+			continue
+		}
+
+		for _, filename := range pkg.GoFiles {
+			if !slices.Contains(filenames, filename) {
+				filenames = append(filenames, filename)
+			}
+		}
+	}
+
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("no Go files matching %q", pattern)
+	}
+
+	return filenames, nil
+}
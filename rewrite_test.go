@@ -0,0 +1,175 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestIsRewriteMode(t *testing.T) {
+	testCases := []struct {
+		desc string
+		args []string
+		want bool
+	}{
+		{
+			desc: "no flags",
+			args: []string{"./..."},
+			want: false,
+		},
+		{
+			desc: "write flag",
+			args: []string{"-w", "./..."},
+			want: true,
+		},
+		{
+			desc: "diff flag with double dash",
+			args: []string{"--d", "a.go"},
+			want: true,
+		},
+		{
+			desc: "disabled diff flag",
+			args: []string{"-d=false", "a.go"},
+			want: false,
+		},
+		{
+			desc: "diff flag after a check flag",
+			args: []string{"-alphabetical", "-d", "a.go"},
+			want: true,
+		},
+		{
+			desc: "diff flag after the value of a space separated flag",
+			args: []string{"-name-comparator", "natural", "-d", "a.go"},
+			want: true,
+		},
+		{
+			desc: "diff flag after the files",
+			args: []string{"a.go", "-d"},
+			want: false,
+		},
+		{
+			desc: "analyzer flag",
+			args: []string{"-fix", "./..."},
+			want: false,
+		},
+		{
+			desc: "help flag",
+			args: []string{"-h"},
+			want: false,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			if got := isRewriteMode(test.args); got != test.want {
+				t.Errorf("isRewriteMode(%q) = %t, want %t", test.args, got, test.want)
+			}
+		})
+	}
+}
+
+func TestGoFiles(t *testing.T) {
+	root := t.TempDir()
+
+	for _, name := range []string{
+		"a.go",
+		"a_test.go",
+		"README.md",
+		"sub/b.go",
+		"sub/deep/c.go",
+		"vendor/v.go",
+		"testdata/t.go",
+		".hidden/h.go",
+		"_ignored/i.go",
+	} {
+		filename := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filename, []byte("package p\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		desc string
+		arg  string
+		want []string
+	}{
+		{
+			desc: "file",
+			arg:  filepath.Join(root, "sub", "b.go"),
+			want: []string{"sub/b.go"},
+		},
+		{
+			desc: "directory",
+			arg:  root,
+			want: []string{"a.go", "a_test.go"},
+		},
+		{
+			desc: "recursive pattern skips vendor, testdata, hidden and underscore directories",
+			arg:  root + "/...",
+			want: []string{"a.go", "a_test.go", "sub/b.go", "sub/deep/c.go"},
+		},
+		{
+			desc: "recursive pattern of a sub directory",
+			arg:  filepath.Join(root, "sub") + "/...",
+			want: []string{"sub/b.go", "sub/deep/c.go"},
+		},
+		{
+			desc: "testdata directory given explicitly",
+			arg:  filepath.Join(root, "testdata"),
+			want: []string{"testdata/t.go"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			filenames, err := goFiles(test.arg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make([]string, 0, len(filenames))
+			for _, filename := range filenames {
+				rel, relErr := filepath.Rel(root, filename)
+				if relErr != nil {
+					t.Fatal(relErr)
+				}
+
+				got = append(got, filepath.ToSlash(rel))
+			}
+
+			slices.Sort(got)
+
+			if !slices.Equal(got, test.want) {
+				t.Errorf("goFiles(%q) = %q, want %q", test.arg, got, test.want)
+			}
+		})
+	}
+}
+
+func TestGoFilesImportPath(t *testing.T) {
+	filenames, err := goFiles("github.com/manuelarte/funcorder/analyzer")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, filename := range filenames {
+		names = append(names, filepath.Base(filename))
+	}
+
+	for _, want := range []string{"analyzer.go", "analyzer_test.go"} {
+		if !slices.Contains(names, want) {
+			t.Errorf("goFiles of the import path = %q, want %q included", names, want)
+		}
+	}
+
+	if _, err = goFiles("./missing"); err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("goFiles of a missing path: got error %v", err)
+	}
+}